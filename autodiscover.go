@@ -0,0 +1,309 @@
+package ews
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	AutodiscoverError errors.Kind = "autodiscover error"
+
+	ErrInvalidEmailAddress   errors.Msg = "invalid email address"
+	ErrAutodiscoverRedirects errors.Msg = "too many autodiscover redirects"
+	ErrNoEwsUrl              errors.Msg = "autodiscover response does not contain an ews url"
+	ErrInsecureRedirect      errors.Msg = "autodiscover redirect url is not https"
+
+	// MaxAutodiscoverRedirects is the maximum number of redirects which are
+	// followed during autodiscover.
+	MaxAutodiscoverRedirects = 10
+)
+
+// AutodiscoverResult contains the settings which are discovered by
+// Client.Autodiscover.
+type AutodiscoverResult struct {
+	// EmailAddress is the email address the settings belong to, which may
+	// differ from the requested address after a redirect.
+	EmailAddress string
+	// EwsUrl is the url of the EWS endpoint.
+	EwsUrl string
+	// ServerVersion is the hexadecimal server version as returned by the
+	// autodiscover service.
+	ServerVersion string
+	// Version is the Version which is derived from ServerVersion. It is empty
+	// when the server version is unknown.
+	Version Version
+}
+
+// NewAutodiscoverClient creates a new Client with the EWS url and Version
+// which are discovered for the provided email address. The credentials from
+// opts are used to authenticate against the autodiscover service.
+func NewAutodiscoverClient(ctx context.Context, email string, opts ...Option) (*Client, error) {
+	c, err := NewClient("", DefaultVersion, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Autodiscover(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Url = res.EwsUrl
+	if res.Version != "" {
+		c.Version = res.Version
+	}
+	return c, nil
+}
+
+// Autodiscover locates the EWS endpoint of the mailbox with the provided
+// email address, using the POX autodiscover service. The following endpoints
+// are tried in order, where the first successful response is used:
+//   - https://<domain>/autodiscover/autodiscover.xml
+//   - https://autodiscover.<domain>/autodiscover/autodiscover.xml
+//   - the https location http://autodiscover.<domain>/autodiscover/autodiscover.xml
+//     redirects to
+//   - https://<target>/autodiscover/autodiscover.xml, where target is the
+//     result of a DNS SRV lookup of _autodiscover._tcp.<domain>
+//
+// Redirects to another email address or https url are followed, up to
+// MaxAutodiscoverRedirects times.
+// https://learn.microsoft.com/en-us/exchange/client-developer/exchange-web-services/autodiscover-for-exchange
+func (c *Client) Autodiscover(ctx context.Context, email string) (*AutodiscoverResult, error) {
+	hops := 0
+	return c.autodiscover(ctx, email, &hops)
+}
+
+func (c *Client) autodiscover(ctx context.Context, email string, hops *int) (*AutodiscoverResult, error) {
+	i := strings.LastIndexByte(email, '@')
+	if i <= 0 || i == len(email)-1 {
+		return nil, errors.New(ErrInvalidEmailAddress)
+	}
+
+	domain := email[i+1:]
+	var err error
+
+	for _, u := range []string{
+		"https://" + domain + autodiscoverPath,
+		"https://autodiscover." + domain + autodiscoverPath,
+	} {
+		res, resErr := c.autodiscoverUrl(ctx, u, email, hops)
+		if resErr == nil || errors.Is(resErr, ErrAutodiscoverRedirects) {
+			return res, resErr
+		}
+		errors.Append(&err, resErr)
+	}
+
+	if u, redirErr := c.autodiscoverRedirectUrl(ctx, "http://autodiscover."+domain+autodiscoverPath); redirErr != nil {
+		errors.Append(&err, redirErr)
+	} else {
+		res, resErr := c.autodiscoverUrl(ctx, u, email, hops)
+		if resErr == nil || errors.Is(resErr, ErrAutodiscoverRedirects) {
+			return res, resErr
+		}
+		errors.Append(&err, resErr)
+	}
+
+	_, srv, srvErr := net.DefaultResolver.LookupSRV(ctx, "autodiscover", "tcp", domain)
+	if srvErr != nil {
+		errors.Append(&err, errors.WithStack(srvErr))
+	}
+	for _, s := range srv {
+		u := "https://" + strings.TrimSuffix(s.Target, ".") + autodiscoverPath
+		if s.Port != 443 {
+			u = "https://" + net.JoinHostPort(strings.TrimSuffix(s.Target, "."), strconv.Itoa(int(s.Port))) + autodiscoverPath
+		}
+
+		res, resErr := c.autodiscoverUrl(ctx, u, email, hops)
+		if resErr == nil || errors.Is(resErr, ErrAutodiscoverRedirects) {
+			return res, resErr
+		}
+		errors.Append(&err, resErr)
+	}
+
+	return nil, errors.WithKind(err, AutodiscoverError)
+}
+
+const autodiscoverPath = "/autodiscover/autodiscover.xml"
+
+//goland:noinspection HttpUrlsUsage
+const (
+	autodiscoverRequestStart = xml.Header + `<Autodiscover xmlns="http://schemas.microsoft.com/exchange/autodiscover/outlook/requestschema/2006"><Request><EMailAddress>`
+	autodiscoverRequestEnd   = `</EMailAddress><AcceptableResponseSchema>http://schemas.microsoft.com/exchange/autodiscover/outlook/responseschema/2006a</AcceptableResponseSchema></Request></Autodiscover>`
+)
+
+// autodiscoverUrl posts an autodiscover request for email to u and follows any
+// redirects within the response.
+func (c *Client) autodiscoverUrl(ctx context.Context, u, email string, hops *int) (_ *AutodiscoverResult, err error) {
+	var body bytes.Buffer
+	body.WriteString(autodiscoverRequestStart)
+	if err := xml.EscapeText(&body, []byte(email)); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	body.WriteString(autodiscoverRequestEnd)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err = c.authorize(httpReq, nil); err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "text/xml")
+	c.logRequest(ctx, httpReq, body.Bytes())
+
+	httpResp, err := c.do(ctx, httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	defer errors.AppendFunc(&err, httpResp.Body.Close)
+	if loc, ok := redirectLocation(httpResp); ok {
+		if err = nextAutodiscoverHop(hops); err != nil {
+			return nil, err
+		}
+		return c.autodiscoverUrl(ctx, loc, email, hops)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, NewError(httpResp)
+	}
+
+	var resp autodiscoverResponse
+	if err = xml.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, errors.WithKind(err, UnmarshalError)
+	}
+	if e := resp.Response.Error; e != nil {
+		return nil, errors.Newf("autodiscover error %s: %s", e.ErrorCode, e.Message)
+	}
+
+	acc := resp.Response.Account
+	switch strings.ToLower(acc.Action) {
+	case "redirectaddr":
+		if err = nextAutodiscoverHop(hops); err != nil {
+			return nil, err
+		}
+		return c.autodiscover(ctx, acc.RedirectAddr, hops)
+
+	case "redirecturl":
+		if loc, err := url.Parse(acc.RedirectUrl); err != nil || !isHttps(loc) {
+			return nil, errors.Wrapf(ErrInsecureRedirect, "%q", acc.RedirectUrl)
+		}
+		if err = nextAutodiscoverHop(hops); err != nil {
+			return nil, err
+		}
+		return c.autodiscoverUrl(ctx, acc.RedirectUrl, email, hops)
+	}
+
+	res := AutodiscoverResult{EmailAddress: email}
+	for _, p := range acc.Protocol {
+		// the external protocol does not always contain the server version
+		if p.ServerVersion != "" {
+			res.ServerVersion = p.ServerVersion
+			res.Version = ParseServerVersion(p.ServerVersion)
+			break
+		}
+	}
+	for _, typ := range []string{"EXPR", "EXCH"} {
+		for _, p := range acc.Protocol {
+			if p.Type == typ && p.EwsUrl != "" {
+				res.EwsUrl = p.EwsUrl
+				return &res, nil
+			}
+		}
+	}
+	return nil, errors.New(ErrNoEwsUrl)
+}
+
+// autodiscoverRedirectUrl sends an unauthenticated GET request to u and
+// returns the https location it redirects to.
+func (c *Client) autodiscoverRedirectUrl(ctx context.Context, u string) (_ string, err error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	httpResp, err := c.do(ctx, httpReq)
+	if err != nil {
+		return "", err
+	}
+
+	defer errors.AppendFunc(&err, httpResp.Body.Close)
+	if loc, ok := redirectLocation(httpResp); ok {
+		return loc, nil
+	}
+	return "", NewError(httpResp)
+}
+
+// redirectLocation returns the location of a redirect response, only when it
+// redirects to a https url.
+func redirectLocation(resp *http.Response) (string, bool) {
+	switch resp.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		return "", false
+	}
+
+	loc, err := resp.Location()
+	if err != nil || !isHttps(loc) {
+		return "", false
+	}
+	return loc.String(), true
+}
+
+// isHttps indicates if u is an absolute https url. Autodiscover only follows
+// those, so credentials are never sent over plain http.
+func isHttps(u *url.URL) bool { return u.Scheme == "https" && u.Host != "" }
+
+func nextAutodiscoverHop(hops *int) error {
+	*hops++
+	if *hops > MaxAutodiscoverRedirects {
+		return errors.New(ErrAutodiscoverRedirects)
+	}
+	return nil
+}
+
+// ParseServerVersion returns the Version which matches the hexadecimal server
+// version from an autodiscover response, using VersionFromServerInfo. It
+// returns an empty Version when the server version is unknown or invalid.
+func ParseServerVersion(hex string) Version {
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return ""
+	}
+
+	return VersionFromServerInfo(ewsxml.ServerVersionInfo{
+		MajorVersion:     int(v>>22) & 0x3f,
+		MinorVersion:     int(v>>16) & 0x3f,
+		MajorBuildNumber: int(v) & 0x7fff,
+	})
+}
+
+type autodiscoverResponse struct {
+	XMLName  xml.Name `xml:"Autodiscover"`
+	Response struct {
+		Error *struct {
+			ErrorCode string
+			Message   string
+		}
+		Account struct {
+			Action       string
+			RedirectAddr string
+			RedirectUrl  string
+			Protocol     []struct {
+				Type          string
+				ServerVersion string
+				EwsUrl        string
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package ews
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Autodiscover(t *testing.T) {
+	const settings = `<?xml version="1.0" encoding="utf-8"?>
+<Autodiscover xmlns="http://schemas.microsoft.com/exchange/autodiscover/responseschema/2006">
+  <Response xmlns="http://schemas.microsoft.com/exchange/autodiscover/outlook/responseschema/2006a">
+    <Account>
+      <AccountType>email</AccountType>
+      <Action>settings</Action>
+      <Protocol>
+        <Type>EXCH</Type>
+        <ServerVersion>738180DA</ServerVersion>
+        <EwsUrl>https://internal.example.com/EWS/Exchange.asmx</EwsUrl>
+      </Protocol>
+      <Protocol>
+        <Type>EXPR</Type>
+        <EwsUrl>https://mail.example.com/EWS/Exchange.asmx</EwsUrl>
+      </Protocol>
+    </Account>
+  </Response>
+</Autodiscover>`
+
+	var srv *httptest.Server
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "pass", pass)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(body), "<EMailAddress>user@example.com</EMailAddress>")
+
+		switch r.URL.Path {
+		case "/moved" + autodiscoverPath:
+			http.Redirect(w, r, srv.URL+"/redirect"+autodiscoverPath, http.StatusFound)
+		case "/insecure" + autodiscoverPath:
+			_, _ = w.Write([]byte(strings.Replace(settings, "<Action>settings</Action>",
+				"<Action>redirectUrl</Action><RedirectUrl>http://example.com"+autodiscoverPath+"</RedirectUrl>", 1)))
+		case "/redirect" + autodiscoverPath:
+			_, _ = w.Write([]byte(strings.Replace(settings, "<Action>settings</Action>",
+				"<Action>redirectUrl</Action><RedirectUrl>"+srv.URL+autodiscoverPath+"</RedirectUrl>", 1)))
+		default:
+			_, _ = w.Write([]byte(settings))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient("", Exchange2013, WithBasicAuth("user", "pass"))
+	assert.NoError(t, err)
+	c.http.Transport = srv.Client().Transport
+
+	hops := 0
+	res, err := c.autodiscoverUrl(context.Background(), srv.URL+"/moved"+autodiscoverPath, "user@example.com", &hops)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, hops)
+	assert.Equal(t, &AutodiscoverResult{
+		EmailAddress:  "user@example.com",
+		EwsUrl:        "https://mail.example.com/EWS/Exchange.asmx",
+		ServerVersion: "738180DA",
+		Version:       Exchange2010_SP1,
+	}, res)
+
+	hops = 0
+	_, err = c.autodiscoverUrl(context.Background(), srv.URL+"/insecure"+autodiscoverPath, "user@example.com", &hops)
+	assert.True(t, errors.Is(err, ErrInsecureRedirect))
+	assert.Equal(t, 0, hops)
+}
+
+func TestParseServerVersion(t *testing.T) {
+	tests := map[string]Version{
+		"738080DA": Exchange2010,
+		"738180DA": Exchange2010_SP1,
+		"73C08204": Exchange2013,
+		"73C0834F": Exchange2013_SP1,
+		"73C18001": Exchange2016,
+		"720180DA": Exchange2007_SP1,
+		"718000DA": "",
+		"invalid":  "",
+	}
+	for hex, want := range tests {
+		t.Run(hex, func(t *testing.T) {
+			assert.Equal(t, want, ParseServerVersion(hex))
+		})
+	}
+}
@@ -1,11 +1,18 @@
 package ews
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/Abovo-Media/go-ews/ewsxml"
@@ -13,16 +20,17 @@ import (
 	"github.com/go-pogo/writing"
 )
 
-type Version = ewsxml.Version
-
 //goland:noinspection GoUnusedConst,GoSnakeCaseUsage
 const (
-	Exchange2010     Version = "Exchange2010"
-	Exchange2013     Version = "Exchange2013"
-	Exchange2013_SP1 Version = "Exchange2013_SP1"
-
 	RequestError   errors.Kind = "request error"
 	UnmarshalError errors.Kind = "unmarshal error"
+	AuthError      errors.Kind = "auth error"
+
+	ErrMultipleAuth   errors.Msg = "only one authentication method can be used at the same time"
+	ErrInvalidRetries errors.Msg = "number of retries must be between 0 and 255"
+	ErrProxyTransport errors.Msg = "proxy can only be set on an *http.Transport"
+	ErrUnknownVersion errors.Msg = "unknown exchange server version"
+	ErrInsecureUrl    errors.Msg = "credentials must not be sent over plain http"
 )
 
 type Requester interface {
@@ -32,21 +40,37 @@ type Requester interface {
 	Request(req *Request, out interface{}) error
 }
 
+// StreamRequester executes requests and returns the raw response body, without
+// buffering it, so large responses can be processed while they are read.
+type StreamRequester interface {
+	Requester
+	// Stream returns the body of the response to req. It must be closed by
+	// the caller.
+	Stream(req *Request) (io.ReadCloser, error)
+}
+
 type Client struct {
 	Config
 
-	log  Logger
-	http *http.Client
+	log         Logger
+	http        *http.Client
+	tokens      TokenSource
+	creds       CredentialsProvider
+	wsse        *ewsxml.UsernameToken
+	impersonate *ewsxml.ConnectingSID
+	timeZone    ewsxml.TimeZoneId
+	culture     ewsxml.MailboxCulture
+	gzip        bool
+	insecure    bool
+	serverVer   atomic.Value
 }
 
 func NewClient(url string, ver Version, opts ...Option) (*Client, error) {
 	c := &Client{
 		log: NopLogger(),
 		http: &http.Client{
-			CheckRedirect: func(req *http.Request, via []*http.Request) error {
-				return http.ErrUseLastResponse
-			},
-			Timeout: time.Second * 10,
+			CheckRedirect: noRedirect,
+			Timeout:       DefaultTimeout,
 		},
 		Config: Config{
 			Url:        url,
@@ -58,11 +82,29 @@ func NewClient(url string, ver Version, opts ...Option) (*Client, error) {
 	if err := c.applyOptions(opts); err != nil {
 		return nil, err
 	}
+	if c.tokens != nil && (c.Username != "" || c.Password != "") {
+		return nil, errors.New(ErrMultipleAuth)
+	}
+	if c.creds != nil && (c.tokens != nil || c.Username != "" || c.Password != "") {
+		return nil, errors.New(ErrMultipleAuth)
+	}
+	if !c.insecure && (c.Username != "" || c.tokens != nil || c.creds != nil || c.wsse != nil) && isPlainHttp(c.Url) {
+		return nil, errors.New(ErrInsecureUrl)
+	}
+	if c.Version == "" {
+		c.Version = DefaultVersion
+	} else if !KnownVersion(c.Version) {
+		return nil, errors.New(ErrUnknownVersion)
+	}
 
 	c.log.NewClient(c.Config)
 	return c, nil
 }
 
+func isPlainHttp(u string) bool {
+	return len(u) >= 7 && strings.EqualFold(u[:7], "http://")
+}
+
 func (c *Client) applyOptions(opts []Option) error {
 	var err error
 	for _, opt := range opts {
@@ -71,12 +113,40 @@ func (c *Client) applyOptions(opts []Option) error {
 	return err
 }
 
+// ServerVersionInfo returns the ewsxml.ServerVersionInfo from the SOAP header
+// of the most recent response which was read by Request. It returns false when
+// no such response has been received yet.
+func (c *Client) ServerVersionInfo() (ewsxml.ServerVersionInfo, bool) {
+	v, ok := c.serverVer.Load().(ewsxml.ServerVersionInfo)
+	return v, ok
+}
+
+// UsesOAuth2 indicates if requests are authenticated with an OAuth2 bearer
+// token instead of basic auth.
+func (c *Client) UsesOAuth2() bool { return c.tokens != nil }
+
 var bufPool = writing.NewBytesBufferPool(512)
 
+// Do executes req and returns the http response. A response with a transient
+// failure is retried up to Config.Retries times, so at most Retries+1
+// attempts are made. A transport error is returned immediately without
+// retrying, as the server may have already processed the request.
 func (c *Client) Do(req *Request) (*http.Response, error) {
 	if req.head.ServerVersion() == "" {
 		req.head.WithServerVersion(c.Version)
 	}
+	if req.head.ExchangeImpersonation == nil && c.impersonate != nil {
+		req.head.WithImpersonation(*c.impersonate)
+	}
+	if req.head.Security == nil && c.wsse != nil {
+		req.head.WithUsernameToken(c.wsse.Username, c.wsse.Password.Value)
+	}
+	if req.head.MailboxCulture == "" {
+		req.head.WithMailboxCulture(c.culture)
+	}
+	if req.head.TimeZoneContext == nil {
+		req.head.WithTimeZoneId(c.timeZone)
+	}
 
 	body := bufPool.Get()
 	defer bufPool.Put(body)
@@ -84,45 +154,225 @@ func (c *Client) Do(req *Request) (*http.Response, error) {
 		return nil, err
 	}
 
-	httpReq, err := http.NewRequestWithContext(req.ctx, http.MethodPost, c.Url, body)
-	if err != nil {
-		return nil, errors.WithStack(err)
+	var compressed []byte
+	if c.gzip {
+		var err error
+		if compressed, err = gzipBytes(body.Bytes()); err != nil {
+			return nil, err
+		}
 	}
 
-	if c.Username != "" && c.Password != "" {
-		if _, _, has := httpReq.BasicAuth(); !has {
-			httpReq.SetBasicAuth(c.Username, c.Password)
+	var attempt uint8
+	for {
+		attempt++
+		httpResp, err := c.send(context.WithValue(req.ctx, attemptKey{}, attempt), req.creds, body.Bytes(), compressed)
+		if err != nil {
+			return nil, err
 		}
+		if httpResp.StatusCode == http.StatusOK || attempt > c.Retries {
+			return httpResp, nil
+		}
+
+		wait, retry, err := c.retryDelay(httpResp, attempt)
+		if err != nil || !retry || !sleep(req.ctx, wait) {
+			return httpResp, err
+		}
+	}
+}
+
+// send posts a single attempt of the request body to the EWS endpoint. When
+// compressed is not nil, it is sent instead of body. When creds is not nil, it
+// is used instead of the credentials of the Client.
+func (c *Client) send(ctx context.Context, creds CredentialsProvider, body, compressed []byte) (*http.Response, error) {
+	payload := body
+	if compressed != nil {
+		payload = compressed
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err = c.authorize(httpReq, creds); err != nil {
+		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "text/xml")
-	c.log.HttpRequest(req.ctx, httpReq, body.Bytes())
+	if compressed != nil {
+		httpReq.Header.Set("Content-Encoding", "gzip")
+		httpReq.Header.Set("Accept-Encoding", "gzip")
+	}
 
-	var httpResp *http.Response
-	var attempt uint8
-	sleep := c.RetrySleep
+	c.logRequest(ctx, httpReq, body)
+	return c.do(ctx, httpReq)
+}
 
-	for {
-		attempt++
-		httpResp, err = c.do(context.WithValue(req.ctx, attemptKey{}, attempt), httpReq)
-		if err == nil && httpResp.StatusCode == http.StatusOK {
-			return httpResp, nil
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := gw.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decompress replaces the body of a gzip encoded resp with a reader which
+// decompresses it. Servers which ignore the Accept-Encoding header and return
+// plain xml, while still claiming a gzip Content-Encoding, are detected by
+// the absence of the gzip header in the body.
+func decompress(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	br := bufio.NewReader(resp.Body)
+	if magic, _ := br.Peek(2); len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		resp.Body = &readCloser{Reader: br, Closer: resp.Body}
+		return nil
+	}
+
+	gr, err := gzip.NewReader(br)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	resp.Body = &readCloser{Reader: gr, Closer: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// retryDelay indicates if the request of the non-OK resp may be retried
+// and how long to wait before doing so. Only transient failures are retried:
+// a 503 or 429 status, or a soap fault with a transient ResponseCode, like
+// ErrorServerBusy. The server's BackOffMilliseconds or Retry-After hint is
+// used when present, otherwise the delay grows exponentially from
+// Config.RetrySleep up to MaxRetrySleep. The body of resp is buffered so it
+// remains readable.
+func (c *Client) retryDelay(resp *http.Response, attempt uint8) (_ time.Duration, _ bool, err error) {
+	data, err := ioutil.ReadAll(resp.Body)
+	errors.AppendFunc(&err, resp.Body.Close)
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return 0, false, errors.WithStack(err)
+	}
+
+	wait := backoff(c.RetrySleep, attempt)
+	if fault, _ := parseSoapFault(string(data)); fault != nil {
+		if !ewsxml.ResponseCode(fault.Detail.ResponseCode).Transient() {
+			return 0, false, nil
+		}
+		if d, ok := fault.BackOff(); ok {
+			wait = d
 		}
-		if attempt >= c.Retries {
-			break
+		return wait, true, nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusServiceUnavailable, http.StatusTooManyRequests:
+		if sec, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && sec >= 0 {
+			wait = time.Duration(sec) * time.Second
 		}
+		return wait, true, nil
+	default:
+		return 0, false, nil
+	}
+}
 
-		time.Sleep(sleep)
-		sleep += sleep
+// backoff returns base doubled for each attempt after the first, limited to
+// MaxRetrySleep.
+func backoff(base time.Duration, attempt uint8) time.Duration {
+	wait := base
+	for i := uint8(1); i < attempt && wait < MaxRetrySleep; i++ {
+		wait *= 2
 	}
-	return httpResp, err
+	if wait > MaxRetrySleep {
+		return MaxRetrySleep
+	}
+	return wait
+}
+
+// sleep waits for d and returns true, or returns false when ctx is done
+// earlier or its deadline expires before d has passed.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return false
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// authorize adds the Authorization header to req, using either creds, or the
+// CredentialsProvider, the OAuth2 token or the basic auth credentials of the
+// Client when creds is nil.
+func (c *Client) authorize(req *http.Request, creds CredentialsProvider) error {
+	if creds == nil {
+		creds = c.creds
+	}
+	if creds != nil {
+		header, err := creds.AuthHeader(req.Context())
+		if err != nil {
+			return errors.WithKind(err, AuthError)
+		}
+		req.Header.Set("Authorization", header)
+	} else if c.tokens != nil {
+		token, err := c.tokens.Token()
+		if err != nil {
+			return errors.WithKind(err, AuthError)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if c.Username != "" && c.Password != "" {
+		if _, _, has := req.BasicAuth(); !has {
+			user := c.Username
+			if c.Domain != "" {
+				// ntlmssp.Negotiator expects the domain as part of the
+				// basic auth username
+				user = c.Domain + `\` + user
+			}
+			req.SetBasicAuth(user, c.Password)
+		}
+	}
+	return nil
 }
 
 func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	hc := c.http
+	if ctx.Value(streamKey{}) != nil && hc.Timeout != 0 {
+		// the timeout of http.Client includes reading the body, which would
+		// cut off long-lived streams, rely on ctx to end those instead
+		noTimeout := *hc
+		noTimeout.Timeout = 0
+		hc = &noTimeout
+	}
+
 	// todo: record metrics
-	resp, err := c.http.Do(req)
+	resp, err := hc.Do(req)
 
 	if err != nil {
+		c.logHttpError(ctx, req, err)
+		return nil, errors.WithKind(err, RequestError)
+	}
+	if err = decompress(resp); err != nil {
+		errors.AppendFunc(&err, resp.Body.Close)
+		c.logHttpError(ctx, req, err)
 		return nil, errors.WithKind(err, RequestError)
 	}
 
@@ -151,6 +401,9 @@ func (c *Client) Request(req *Request, out interface{}) (err error) {
 	if err = xml.Unmarshal(data, &resp); err != nil {
 		return errors.WithKind(err, UnmarshalError)
 	}
+	if resp.Header.ServerVersionInfo != nil {
+		c.serverVer.Store(*resp.Header.ServerVersionInfo)
+	}
 
 	if b, ok := out.(*[]byte); ok {
 		// skip unmarshalling, return as raw bytes
@@ -162,16 +415,67 @@ func (c *Client) Request(req *Request, out interface{}) (err error) {
 	if err = xml.Unmarshal(resp.Body.Response, out); err != nil {
 		return errors.WithKind(err, UnmarshalError)
 	}
-	if resp, ok := out.(ewsxml.Response); ok {
-		c.log.Response(req.ctx, *resp.Response())
-		if err = newResponseError(resp.Response()); err != nil {
-			return errors.WithStack(err)
+
+	respOut, ok := out.(ewsxml.Response)
+	if !ok {
+		// out does not expose its response messages, find them ourselves
+		var msgs responseMessages
+		if xml.Unmarshal(resp.Body.Response, &msgs) != nil || len(msgs.ResponseMessages.Messages) == 0 {
+			return nil
 		}
+		respOut = &msgs
 	}
 
+	c.log.Response(req.ctx, *respOut.Response())
+	if err = newResponseError(respOut.Response()); err != nil {
+		return errors.WithStack(err)
+	}
 	return nil
 }
 
+// responseMessages captures the response messages of any operation.
+type responseMessages struct {
+	ResponseMessages struct {
+		Messages []ewsxml.ResponseMessage `xml:",any"`
+	}
+}
+
+// Response returns the first response message with an Error ResponseClass,
+// or the first response message when none failed.
+func (r *responseMessages) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.Messages
+	for i := range msgs {
+		if msgs[i].ResponseClass == ewsxml.ResponseClass_Error {
+			return &msgs[i]
+		}
+	}
+	return &msgs[0]
+}
+
+type streamKey struct{}
+
+// Stream executes req and returns the body of the response, which must be
+// closed by the caller. Unlike Request, the body is not read into memory.
+// The timeout set with WithTimeout does not apply to streamed requests, use
+// the context of req to limit their duration.
+func (c *Client) Stream(req *Request) (io.ReadCloser, error) {
+	sr := *req
+	sr.ctx = context.WithValue(req.ctx, streamKey{}, true)
+
+	httpResp, err := c.Do(&sr)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		err = NewError(httpResp)
+		errors.AppendFunc(&err, httpResp.Body.Close)
+		return nil, err
+	}
+	return httpResp.Body, nil
+}
+
+// ResponseError is returned by Client.Request when a response message has an
+// Error ResponseClass.
 type ResponseError struct {
 	Response ewsxml.Response
 }
@@ -187,3 +491,46 @@ func (re *ResponseError) Error() string {
 	r := re.Response.Response()
 	return fmt.Sprintf("response error: %s: %s", r.ResponseCode, r.MessageText)
 }
+
+// ResponseClass returns the ewsxml.ResponseClass of the failed response
+// message.
+func (re *ResponseError) ResponseClass() ewsxml.ResponseClass {
+	return re.Response.Response().ResponseClass
+}
+
+// ResponseCode returns the ewsxml.ResponseCode of the failed response
+// message.
+func (re *ResponseError) ResponseCode() ewsxml.ResponseCode {
+	return re.Response.Response().ResponseCode
+}
+
+// MessageText returns the description of the error.
+func (re *ResponseError) MessageText() string {
+	return re.Response.Response().MessageText
+}
+
+// Is indicates whether target is the ewsxml.ResponseCode of the response.
+func (re *ResponseError) Is(target error) bool {
+	code, ok := target.(ewsxml.ResponseCode)
+	return ok && code == re.ResponseCode()
+}
+
+// ResponseCodeOf returns the ewsxml.ResponseCode of the ResponseError or
+// SoapError within err's chain. It returns false when err does not contain
+// any of these errors.
+//
+//	switch code, _ := ews.ResponseCodeOf(err); code {
+//	case ewsxml.ErrorItemNotFound:
+//		// ...
+//	}
+func ResponseCodeOf(err error) (ewsxml.ResponseCode, bool) {
+	var re *ResponseError
+	if errors.As(err, &re) {
+		return re.ResponseCode(), true
+	}
+	var se *SoapError
+	if errors.As(err, &se) {
+		return se.ResponseCode(), true
+	}
+	return "", false
+}
@@ -0,0 +1,456 @@
+package ews
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const serverBusyFault = `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <s:Fault>
+      <faultcode xmlns:a="http://schemas.microsoft.com/exchange/services/2006/types">a:ErrorServerBusy</faultcode>
+      <faultstring xml:lang="en-US">The server cannot service this request right now. Try again later.</faultstring>
+      <detail>
+        <e:ResponseCode xmlns:e="http://schemas.microsoft.com/exchange/services/2006/errors">ErrorServerBusy</e:ResponseCode>
+        <e:Message xmlns:e="http://schemas.microsoft.com/exchange/services/2006/errors">The server cannot service this request right now. Try again later.</e:Message>
+        <t:MessageXml xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+          <t:Value Name="BackOffMilliseconds">5</t:Value>
+        </t:MessageXml>
+      </detail>
+    </s:Fault>
+  </s:Body>
+</s:Envelope>`
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return fn(req) }
+
+func TestClient_Do(t *testing.T) {
+	newServer := func(t *testing.T, calls *int, fail int, status int, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls++
+			data, _ := ioutil.ReadAll(r.Body)
+			assert.Contains(t, string(data), "<m:GetRoomLists></m:GetRoomLists>")
+
+			if *calls <= fail {
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(body))
+				return
+			}
+			_, _ = w.Write([]byte(soapMessage))
+		}))
+	}
+
+	t.Run("retry server busy", func(t *testing.T) {
+		var calls int
+		srv := newServer(t, &calls, 2, http.StatusInternalServerError, serverBusyFault)
+		defer srv.Close()
+
+		c, err := NewClient(srv.URL, Exchange2013, WithRetry(3, time.Hour))
+		assert.NoError(t, err)
+
+		resp, err := c.Do(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("max retries", func(t *testing.T) {
+		var calls int
+		srv := newServer(t, &calls, 5, http.StatusServiceUnavailable, "")
+		defer srv.Close()
+
+		c, err := NewClient(srv.URL, Exchange2013, WithRetry(2, time.Millisecond))
+		assert.NoError(t, err)
+
+		resp, err := c.Do(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("not retryable", func(t *testing.T) {
+		var calls int
+		srv := newServer(t, &calls, 5, http.StatusInternalServerError, soapMessageWithFault)
+		defer srv.Close()
+
+		c, err := NewClient(srv.URL, Exchange2013, WithRetry(2, time.Millisecond))
+		assert.NoError(t, err)
+
+		resp, err := c.Do(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, soapErr, NewError(resp))
+	})
+	t.Run("too many requests", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(soapMessage))
+		}))
+		defer srv.Close()
+
+		// base would block the test when Retry-After is not honored
+		c, err := NewClient(srv.URL, Exchange2013, WithRetry(1, time.Hour))
+		assert.NoError(t, err)
+
+		resp, err := c.Do(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("no retries", func(t *testing.T) {
+		var calls int
+		srv := newServer(t, &calls, 5, http.StatusServiceUnavailable, "")
+		defer srv.Close()
+
+		c, err := NewClient(srv.URL, Exchange2013, WithRetry(0, time.Millisecond))
+		assert.NoError(t, err)
+
+		resp, err := c.Do(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("transport error", func(t *testing.T) {
+		var calls int
+		c, err := NewClient("http://example.com/EWS/Exchange.asmx", Exchange2013,
+			WithRetry(2, time.Millisecond),
+			WithTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+				calls++
+				return nil, errors.New("connection reset")
+			})),
+		)
+		assert.NoError(t, err)
+
+		_, err = c.Do(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}))
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("deadline", func(t *testing.T) {
+		var calls int
+		srv := newServer(t, &calls, 5, http.StatusServiceUnavailable, "")
+		defer srv.Close()
+
+		c, err := NewClient(srv.URL, Exchange2013, WithRetry(2, time.Minute))
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		resp, err := c.Do(NewRequest(ctx, nil, ewsxml.GetRoomLists{}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(time.Second, 1))
+	assert.Equal(t, 4*time.Second, backoff(time.Second, 3))
+	assert.Equal(t, MaxRetrySleep, backoff(time.Second, 40))
+	assert.Equal(t, MaxRetrySleep, backoff(time.Second, math.MaxUint8))
+	assert.Equal(t, MaxRetrySleep, backoff(time.Hour, 2))
+	assert.Equal(t, time.Duration(0), backoff(0, math.MaxUint8))
+}
+
+func TestWithTimeout(t *testing.T) {
+	c, err := NewClient("", Exchange2013)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultTimeout, c.http.Timeout)
+
+	c, err = NewClient("", Exchange2013, WithTimeout(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, c.http.Timeout)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	hc := &http.Client{Timeout: time.Minute}
+	c, err := NewClient("", Exchange2013, WithHTTPClient(hc))
+	assert.NoError(t, err)
+	assert.NotSame(t, hc, c.http)
+	assert.Nil(t, c.http.CheckRedirect)
+	assert.Equal(t, time.Minute, c.http.Timeout)
+
+	c, err = NewClient("", Exchange2013, WithHTTPClient(hc), WithoutRedirects(), WithTimeout(time.Second))
+	assert.NoError(t, err)
+	assert.NotNil(t, c.http.CheckRedirect)
+	assert.Equal(t, time.Second, c.http.Timeout)
+
+	// options applied to the client do not change hc
+	assert.Nil(t, hc.CheckRedirect)
+	assert.Equal(t, time.Minute, hc.Timeout)
+}
+
+func TestWithProxy(t *testing.T) {
+	c, err := NewClient("", Exchange2013, WithNTLM("domain", "user", "pass"), WithProxy("http://proxy.example.com:8080"))
+	assert.NoError(t, err)
+
+	tr, ok := c.httpTransport()
+	assert.True(t, ok)
+	assert.NotSame(t, http.DefaultTransport, tr)
+
+	u, err := tr.Proxy(httptest.NewRequest(http.MethodPost, "https://mail.example.com/EWS/Exchange.asmx", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:8080", u.String())
+
+	_, err = NewClient("", Exchange2013, WithTransport(&testTransport{}), WithProxy("http://proxy.example.com:8080"))
+	assert.True(t, errors.Is(err, ErrProxyTransport))
+}
+
+func TestWithDefaultTransport(t *testing.T) {
+	c, err := NewClient("", Exchange2013, WithDefaultTransport(true), WithProxy("http://proxy.example.com:8080"))
+	assert.NoError(t, err)
+
+	tr, ok := c.httpTransport()
+	assert.True(t, ok)
+	assert.NotSame(t, http.DefaultTransport, tr)
+	assert.True(t, tr.TLSClientConfig.InsecureSkipVerify)
+
+	dt := http.DefaultTransport.(*http.Transport)
+	assert.False(t, dt.TLSClientConfig != nil && dt.TLSClientConfig.InsecureSkipVerify)
+	if u, _ := dt.Proxy(httptest.NewRequest(http.MethodPost, "https://mail.example.com/EWS/Exchange.asmx", nil)); u != nil {
+		assert.NotEqual(t, "http://proxy.example.com:8080", u.String())
+	}
+}
+
+func TestWithGzip(t *testing.T) {
+	for name, compress := range map[string]bool{"gzip response": true, "plain response": false} {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+				assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+
+				gr, err := gzip.NewReader(r.Body)
+				assert.NoError(t, err)
+				data, _ := ioutil.ReadAll(gr)
+				assert.Contains(t, string(data), "<m:GetRoomLists></m:GetRoomLists>")
+
+				// the header is set even when the body is not compressed
+				w.Header().Set("Content-Encoding", "gzip")
+				if !compress {
+					_, _ = w.Write([]byte(soapMessage))
+					return
+				}
+
+				gw := gzip.NewWriter(w)
+				_, _ = gw.Write([]byte(soapMessage))
+				_ = gw.Close()
+			}))
+			defer srv.Close()
+
+			c, err := NewClient(srv.URL, Exchange2013, WithGzip())
+			assert.NoError(t, err)
+
+			var out []byte
+			assert.NoError(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out))
+			assert.Contains(t, string(out), "CreateItemResponse")
+		})
+	}
+}
+
+func TestResponseCodeOf(t *testing.T) {
+	respErr := errors.WithStack(&ResponseError{Response: &ewsxml.ResponseMessage{
+		ResponseClass: ewsxml.ResponseClass_Error,
+		ResponseCode:  ewsxml.ErrorItemNotFound,
+	}})
+	assert.True(t, errors.Is(respErr, ewsxml.ErrorItemNotFound))
+	assert.False(t, errors.Is(respErr, ewsxml.ErrorAccessDenied))
+
+	code, ok := ResponseCodeOf(respErr)
+	assert.True(t, ok)
+	assert.Equal(t, ewsxml.ErrorItemNotFound, code)
+
+	assert.True(t, errors.Is(soapErr, ewsxml.ErrorSchemaValidation))
+	code, ok = ResponseCodeOf(soapErr)
+	assert.True(t, ok)
+	assert.Equal(t, ewsxml.ErrorSchemaValidation, code)
+
+	_, ok = ResponseCodeOf(errors.New("some error"))
+	assert.False(t, ok)
+}
+
+func TestClient_Request(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>
+<m:GetItemResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"><m:ResponseMessages>
+<m:GetItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:GetItemResponseMessage>
+<m:GetItemResponseMessage ResponseClass="Error"><m:MessageText>The specified object was not found in the store.</m:MessageText>
+<m:ResponseCode>ErrorItemNotFound</m:ResponseCode></m:GetItemResponseMessage>
+</m:ResponseMessages></m:GetItemResponse></s:Body></s:Envelope>`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL, Exchange2013)
+	assert.NoError(t, err)
+
+	// out does not implement ewsxml.Response
+	var out struct {
+		XMLName xml.Name `xml:"GetItemResponse"`
+	}
+	err = c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out)
+
+	var re *ResponseError
+	assert.True(t, errors.As(err, &re))
+	assert.Equal(t, ewsxml.ResponseClass_Error, re.ResponseClass())
+	assert.Equal(t, ewsxml.ErrorItemNotFound, re.ResponseCode())
+	assert.Equal(t, "The specified object was not found in the store.", re.MessageText())
+}
+
+func TestClient_ServerVersionInfo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(soapMessage))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL, Exchange2013)
+	assert.NoError(t, err)
+
+	_, ok := c.ServerVersionInfo()
+	assert.False(t, ok)
+
+	var out []byte
+	assert.NoError(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out))
+
+	info, ok := c.ServerVersionInfo()
+	assert.True(t, ok)
+	assert.Equal(t, ewsxml.ServerVersionInfo{
+		MajorVersion:     15,
+		MinorVersion:     20,
+		MajorBuildNumber: 2495,
+		MinorBuildNumber: 20,
+		Version:          "V2018_01_08",
+	}, info)
+}
+
+func TestNewClient_Insecure(t *testing.T) {
+	const (
+		httpUrl  = "http://example.com/EWS/Exchange.asmx"
+		upperUrl = "HTTP://example.com/EWS/Exchange.asmx"
+		httpsUrl = "https://example.com/EWS/Exchange.asmx"
+	)
+
+	tests := map[string]Option{
+		"basic auth":     WithBasicAuth("user", "pass"),
+		"ntlm":           WithNTLM("domain", "user", "pass"),
+		"oauth2":         WithOAuth2("token"),
+		"token source":   WithTokenSource(staticToken("token")),
+		"credentials":    WithCredentials(BasicCredentials("user", "pass")),
+		"username token": WithUsernameToken("user", "pass"),
+	}
+	for name, opt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewClient(httpUrl, Exchange2013, opt)
+			assert.True(t, errors.Is(err, ErrInsecureUrl))
+
+			_, err = NewClient(upperUrl, Exchange2013, opt)
+			assert.True(t, errors.Is(err, ErrInsecureUrl))
+
+			_, err = NewClient(httpUrl, Exchange2013, opt, WithAllowInsecure())
+			assert.NoError(t, err)
+
+			_, err = NewClient(httpsUrl, Exchange2013, opt)
+			assert.NoError(t, err)
+		})
+	}
+
+	_, err := NewClient(httpUrl, Exchange2013)
+	assert.NoError(t, err)
+}
+
+func TestWithCredentials(t *testing.T) {
+	var headers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(soapMessage))
+	}))
+	defer srv.Close()
+
+	var n int
+	c, err := NewClient(srv.URL, Exchange2013, WithCredentials(BearerCredentials(TokenSourceFunc(func() (string, error) {
+		n++
+		return "token" + strconv.Itoa(n), nil
+	}))), WithAllowInsecure())
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		var out []byte
+		assert.NoError(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out))
+	}
+	assert.Equal(t, []string{"Bearer token1", "Bearer token2"}, headers)
+
+	_, err = NewClient(srv.URL, Exchange2013, WithCredentials(BasicCredentials("user", "pass")), WithOAuth2("token"))
+	assert.True(t, errors.Is(err, ErrMultipleAuth))
+}
+
+func TestBasicCredentials(t *testing.T) {
+	header, err := BasicCredentials("user", "pass").AuthHeader(context.Background())
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com", nil)
+	req.Header.Set("Authorization", header)
+	user, pass, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "pass", pass)
+}
+
+func TestRequest_WithCredentials(t *testing.T) {
+	var headers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("Authorization"))
+		data, _ := ioutil.ReadAll(r.Body)
+		if len(headers) == 2 {
+			assert.Contains(t, string(data), "<SmtpAddress>other@example.com</SmtpAddress>")
+		}
+		_, _ = w.Write([]byte(soapMessage))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL, Exchange2013, WithOAuth2("client"), WithAllowInsecure())
+	assert.NoError(t, err)
+
+	var out []byte
+	assert.NoError(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out))
+	assert.NoError(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}).
+		WithOAuth2("mailbox").
+		WithImpersonation(ewsxml.ConnectingSID{SmtpAddress: "other@example.com"}), &out))
+
+	assert.Equal(t, []string{"Bearer client", "Bearer mailbox"}, headers)
+}
+
+func TestWithUsernameToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(data), `<soap:Header><wsse:Security `)
+		assert.Contains(t, string(data), `<wsse:Username>user</wsse:Username>`)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(soapMessage))
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL, Exchange2013, WithUsernameToken("user", "pass"))
+	assert.True(t, errors.Is(err, ErrInsecureUrl))
+
+	c, err := NewClient(srv.URL, Exchange2013, WithUsernameToken("user", "pass"), WithAllowInsecure())
+	assert.NoError(t, err)
+
+	var out []byte
+	assert.NoError(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out))
+}
@@ -0,0 +1,238 @@
+package ewsop
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getattachment-operation
+type GetAttachmentOperation struct {
+	Header        ewsxml.Header
+	GetAttachment ewsxml.GetAttachment
+}
+
+type GetAttachmentResponse struct {
+	XMLName          xml.Name `xml:"GetAttachmentResponse"`
+	ResponseMessages struct {
+		GetAttachmentResponseMessage []ewsxml.GetAttachmentResponseMessage
+	}
+}
+
+func (r *GetAttachmentResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.GetAttachmentResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpGetAttachment Operation = "GetAttachment"
+
+func GetAttachment(ctx context.Context, req ews.Requester, op *GetAttachmentOperation) (*GetAttachmentResponse, error) {
+	var out GetAttachmentResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpGetAttachment), &op.Header, op.GetAttachment),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createattachment-operation
+type CreateAttachmentOperation struct {
+	Header           ewsxml.Header
+	CreateAttachment ewsxml.CreateAttachment
+}
+
+type CreateAttachmentResponse struct {
+	XMLName          xml.Name `xml:"CreateAttachmentResponse"`
+	ResponseMessages struct {
+		CreateAttachmentResponseMessage []ewsxml.CreateAttachmentResponseMessage
+	}
+}
+
+func (r *CreateAttachmentResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.CreateAttachmentResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// AttachmentIds returns the AttachmentId of each created attachment.
+func (r *CreateAttachmentResponse) AttachmentIds() []ewsxml.AttachmentId {
+	var res []ewsxml.AttachmentId
+	for _, msg := range r.ResponseMessages.CreateAttachmentResponseMessage {
+		res = append(res, msg.Attachments.AttachmentIds()...)
+	}
+	return res
+}
+
+// ParentItemId returns the ItemId of the parent item including its new
+// ChangeKey. Each created attachment changes the ChangeKey of the parent item,
+// so the RootItemId of the last attachment which has one is returned.
+func (r *CreateAttachmentResponse) ParentItemId() ewsxml.ItemId {
+	ids := r.AttachmentIds()
+	for i := len(ids) - 1; i >= 0; i-- {
+		if ids[i].RootItemId != "" {
+			return ewsxml.ItemId{Id: ids[i].RootItemId, ChangeKey: ids[i].RootItemChangeKey}
+		}
+	}
+	return ewsxml.ItemId{}
+}
+
+const OpCreateAttachment Operation = "CreateAttachment"
+
+func CreateAttachment(ctx context.Context, req ews.Requester, op *CreateAttachmentOperation) (*CreateAttachmentResponse, error) {
+	var out CreateAttachmentResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpCreateAttachment), &op.Header, op.CreateAttachment),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteattachment-operation
+type DeleteAttachmentOperation struct {
+	Header           ewsxml.Header
+	DeleteAttachment ewsxml.DeleteAttachment
+}
+
+// DeleteAttachmentResponse contains a DeleteAttachmentResponseMessage for each
+// attachment in the request, in the same order. An attachment which cannot be
+// deleted, e.g. because its id is stale, results in an error response message
+// for that attachment only.
+type DeleteAttachmentResponse struct {
+	XMLName          xml.Name `xml:"DeleteAttachmentResponse"`
+	ResponseMessages struct {
+		DeleteAttachmentResponseMessage []ewsxml.DeleteAttachmentResponseMessage
+	}
+}
+
+func (r *DeleteAttachmentResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.DeleteAttachmentResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpDeleteAttachment Operation = "DeleteAttachment"
+
+func DeleteAttachment(ctx context.Context, req ews.Requester, op *DeleteAttachmentOperation) (*DeleteAttachmentResponse, error) {
+	var out DeleteAttachmentResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpDeleteAttachment), &op.Header, op.DeleteAttachment),
+		&out,
+	)
+}
+
+const ErrNoAttachmentContent errors.Msg = "response does not contain attachment content"
+
+// GetAttachmentContent gets the file attachment identified by id and returns
+// a reader which decodes its base64 Content while it is being read from the
+// response. This prevents large attachments from being buffered in memory.
+// The returned io.ReadCloser must be closed by the caller. A failed response
+// is returned as an *ews.ResponseError.
+func GetAttachmentContent(ctx context.Context, req ews.StreamRequester, head *ewsxml.Header, id ewsxml.AttachmentId) (io.ReadCloser, error) {
+	body, err := req.Stream(ews.NewRequest(
+		setOperation(ctx, OpGetAttachment),
+		head,
+		ewsxml.GetAttachment{AttachmentIds: []ewsxml.AttachmentId{id}},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(body)
+	if err = skipToElement(br, "Content", ErrNoAttachmentContent); err != nil {
+		errors.AppendFunc(&err, body.Close)
+		return nil, err
+	}
+
+	return &contentReader{
+		Reader: base64.NewDecoder(base64.StdEncoding, &charDataReader{r: br}),
+		Closer: body,
+	}, nil
+}
+
+type contentReader struct {
+	io.Reader
+	io.Closer
+}
+
+// skipToElement decodes the response read from r until it has read the start
+// tag of the first element named local, leaving r positioned at its
+// character data. A response message with an Error ResponseClass is returned
+// as an *ews.ResponseError, notFound is returned when r does not contain the
+// element.
+func skipToElement(r *bufio.Reader, local string, notFound errors.Msg) error {
+	// r is an io.ByteReader so dec reads from it without buffering ahead
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return errors.New(notFound)
+		} else if err != nil {
+			return errors.WithKind(err, ews.UnmarshalError)
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local == local {
+			return nil
+		}
+		if !strings.HasSuffix(start.Name.Local, "ResponseMessage") || !isErrorClass(start) {
+			continue
+		}
+
+		var msg ewsxml.ResponseMessage
+		if err = dec.DecodeElement(&msg, &start); err != nil {
+			return errors.WithKind(err, ews.UnmarshalError)
+		}
+		return errors.WithStack(&ews.ResponseError{Response: &msg})
+	}
+}
+
+func isErrorClass(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "ResponseClass" {
+			return ewsxml.ResponseClass(attr.Value) == ewsxml.ResponseClass_Error
+		}
+	}
+	return false
+}
+
+// charDataReader reads from r until the start of the next xml element.
+type charDataReader struct {
+	r    *bufio.Reader
+	done bool
+}
+
+func (cr *charDataReader) Read(p []byte) (int, error) {
+	if cr.done {
+		return 0, io.EOF
+	}
+
+	var n int
+	for n < len(p) {
+		b, err := cr.r.ReadByte()
+		if err != nil {
+			return n, err
+		}
+		if b == '<' {
+			cr.done = true
+			if n == 0 {
+				return 0, io.EOF
+			}
+			return n, nil
+		}
+		p[n] = b
+		n++
+	}
+	return n, nil
+}
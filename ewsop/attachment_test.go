@@ -0,0 +1,88 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type streamRequester struct {
+	ews.Requester
+	body string
+}
+
+func (sr *streamRequester) Stream(*ews.Request) (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader(sr.body)), nil
+}
+
+func TestGetAttachmentContent(t *testing.T) {
+	t.Run("content", func(t *testing.T) {
+		req := &streamRequester{body: `<s:Envelope><s:Body><m:GetAttachmentResponse><m:ResponseMessages>
+<m:GetAttachmentResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+<m:Attachments><t:FileAttachment><t:AttachmentId Id="AAAtAEFkbWluaX"/><t:Name>hello.txt</t:Name>
+<t:ContentType>text/plain</t:ContentType><t:Content>aGVsbG8g
+d29ybGQ=</t:Content></t:FileAttachment></m:Attachments></m:GetAttachmentResponseMessage>
+</m:ResponseMessages></m:GetAttachmentResponse></s:Body></s:Envelope>`}
+
+		r, err := GetAttachmentContent(context.Background(), req, nil, ewsxml.AttachmentId{Id: "AAAtAEFkbWluaX"})
+		assert.NoError(t, err)
+
+		data, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+		assert.NoError(t, r.Close())
+	})
+	t.Run("no content", func(t *testing.T) {
+		req := &streamRequester{body: `<s:Envelope><s:Body></s:Body></s:Envelope>`}
+
+		_, err := GetAttachmentContent(context.Background(), req, nil, ewsxml.AttachmentId{Id: "AAAtAEFkbWluaX"})
+		assert.True(t, errors.Is(err, ErrNoAttachmentContent))
+	})
+	t.Run("error response", func(t *testing.T) {
+		req := &streamRequester{body: `<s:Envelope><s:Body><m:GetAttachmentResponse><m:ResponseMessages>
+<m:GetAttachmentResponseMessage ResponseClass="Error"><m:MessageText>The specified object was not found in the store.</m:MessageText>
+<m:ResponseCode>ErrorItemNotFound</m:ResponseCode><m:DescriptiveLinkKey>0</m:DescriptiveLinkKey>
+<m:Attachments/></m:GetAttachmentResponseMessage></m:ResponseMessages></m:GetAttachmentResponse></s:Body></s:Envelope>`}
+
+		_, err := GetAttachmentContent(context.Background(), req, nil, ewsxml.AttachmentId{Id: "AAAtAEFkbWluaX"})
+		var re *ews.ResponseError
+		if assert.True(t, errors.As(err, &re)) {
+			assert.Equal(t, ewsxml.ErrorItemNotFound, re.Response.Response().ResponseCode)
+			assert.Equal(t, "The specified object was not found in the store.", re.Response.Response().MessageText)
+		}
+	})
+}
+
+func TestCreateAttachmentResponse(t *testing.T) {
+	const data = `<m:CreateAttachmentResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseMessages>
+    <m:CreateAttachmentResponseMessage ResponseClass="Success">
+      <m:ResponseCode>NoError</m:ResponseCode>
+      <m:Attachments>
+        <t:FileAttachment><t:AttachmentId Id="AAAtAEFkbWluaA" RootItemId="AAAtAEFkbWluaX" RootItemChangeKey="CQAAABYAAAB" /></t:FileAttachment>
+      </m:Attachments>
+    </m:CreateAttachmentResponseMessage>
+    <m:CreateAttachmentResponseMessage ResponseClass="Success">
+      <m:ResponseCode>NoError</m:ResponseCode>
+      <m:Attachments>
+        <t:FileAttachment><t:AttachmentId Id="AAAtAEFkbWluaB" RootItemId="AAAtAEFkbWluaX" RootItemChangeKey="CQAAABYAAAC" /></t:FileAttachment>
+      </m:Attachments>
+    </m:CreateAttachmentResponseMessage>
+  </m:ResponseMessages>
+</m:CreateAttachmentResponse>`
+
+	var resp CreateAttachmentResponse
+	assert.NoError(t, xml.Unmarshal([]byte(data), &resp))
+	assert.Len(t, resp.AttachmentIds(), 2)
+	// the ChangeKey of the last created attachment is the current one
+	assert.Equal(t, ewsxml.ItemId{Id: "AAAtAEFkbWluaX", ChangeKey: "CQAAABYAAAC"}, resp.ParentItemId())
+}
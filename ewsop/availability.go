@@ -0,0 +1,71 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuseravailability-operation
+type GetUserAvailabilityOperation struct {
+	Header                     ewsxml.Header
+	GetUserAvailabilityRequest ewsxml.GetUserAvailabilityRequest
+}
+
+type GetUserAvailabilityResponse struct {
+	XMLName               xml.Name                  `xml:"GetUserAvailabilityResponse"`
+	FreeBusyResponseArray []ewsxml.FreeBusyResponse `xml:"FreeBusyResponseArray>FreeBusyResponse"`
+	SuggestionsResponse   *ewsxml.SuggestionsResponse
+}
+
+func (r *GetUserAvailabilityResponse) Response() *ewsxml.ResponseMessage {
+	if r.SuggestionsResponse != nil && r.SuggestionsResponse.ResponseMessage.ResponseClass == ewsxml.ResponseClass_Error {
+		return r.SuggestionsResponse.Response()
+	}
+	return firstResponse(len(r.FreeBusyResponseArray), func(i int) *ewsxml.ResponseMessage {
+		return r.FreeBusyResponseArray[i].Response()
+	})
+}
+
+// FreeBusyViews returns the FreeBusyView of each requested mailbox, in the
+// order of the request's MailboxDataArray.
+func (r *GetUserAvailabilityResponse) FreeBusyViews() []ewsxml.FreeBusyView {
+	res := make([]ewsxml.FreeBusyView, len(r.FreeBusyResponseArray))
+	for i, fb := range r.FreeBusyResponseArray {
+		res[i] = fb.FreeBusyView
+	}
+	return res
+}
+
+// Suggestions returns the suggested meeting times per day, when
+// SuggestionsViewOptions were provided in the request.
+func (r *GetUserAvailabilityResponse) Suggestions() []ewsxml.SuggestionDayResult {
+	if r.SuggestionsResponse == nil {
+		return nil
+	}
+	return r.SuggestionsResponse.SuggestionDayResultArray
+}
+
+const OpGetUserAvailability Operation = "GetUserAvailability"
+
+// GetUserAvailability requests the availability of the mailboxes in the
+// request. An error is returned when the availability of any of the mailboxes
+// could not be retrieved, the response still contains the results of the other
+// mailboxes.
+func GetUserAvailability(ctx context.Context, req ews.Requester, op *GetUserAvailabilityOperation) (*GetUserAvailabilityResponse, error) {
+	ctx = setOperation(ctx, OpGetUserAvailability)
+
+	if opts := op.GetUserAvailabilityRequest.FreeBusyViewOptions; opts != nil && opts.RequestedView == "" {
+		opts.RequestedView = ewsxml.FreeBusyViewType_FreeBusy
+	}
+	for i, md := range op.GetUserAvailabilityRequest.MailboxDataArray {
+		if md.AttendeeType == "" {
+			op.GetUserAvailabilityRequest.MailboxDataArray[i].AttendeeType = ewsxml.AttendeeType_Required
+		}
+	}
+
+	var out GetUserAvailabilityResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.GetUserAvailabilityRequest), &out)
+}
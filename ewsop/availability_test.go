@@ -1,109 +1,21 @@
-package ews
+package ewsop
 
 import (
-	"encoding/xml"
-	"log"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/Abovo-Media/go-ews"
 	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
 	"github.com/stretchr/testify/assert"
 )
 
-func Test_marshal_GetUserAvailabilityRequest(t *testing.T) {
-
-	mb := make([]MailboxData, 0)
-	mb = append(mb, MailboxData{
-		Email: Email{
-			Name:        "",
-			Address:     "someone@ExServer.example.com",
-			RoutingType: "SMTP",
-		},
-		AttendeeType:     AttendeeTypeOrganizer,
-		ExcludeConflicts: false,
-	})
-
-	start, _ := time.Parse(time.RFC3339, "2006-02-06T00:00:00Z")
-	end, _ := time.Parse(time.RFC3339, "2006-02-25T23:59:59Z")
-
-	req := GetUserAvailabilityRequest{
-		TimeZone: ewsxml.TimeZone{
-			Bias: 480,
-			StandardTime: ewsxml.TimeZoneTime{
-				Bias:      0,
-				Time:      "02:00:00",
-				DayOrder:  5,
-				Month:     10,
-				DayOfWeek: "Sunday",
-			},
-			DaylightTime: ewsxml.TimeZoneTime{
-				Bias:      -60,
-				Time:      "02:00:00",
-				DayOrder:  1,
-				Month:     4,
-				DayOfWeek: "Sunday",
-			},
-		},
-		MailboxDataArray: MailboxDataArray{MailboxData: mb},
-		FreeBusyViewOptions: FreeBusyViewOptions{
-			TimeWindow: ewsxml.TimeWindow{
-				StartTime: start,
-				EndTime:   end,
-			},
-			MergedFreeBusyIntervalInMinutes: 60,
-			RequestedView:                   RequestedViewFreeBusyMerged,
-		},
-	}
-
-	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	assert.Equal(t, `<m:GetUserAvailabilityRequest>
-  <t:TimeZone>
-    <t:Bias>480</t:Bias>
-    <t:StandardTime>
-      <t:Bias>0</t:Bias>
-      <t:Time>02:00:00</t:Time>
-      <t:DayOrder>5</t:DayOrder>
-      <t:Month>10</t:Month>
-      <t:DayOfWeek>Sunday</t:DayOfWeek>
-    </t:StandardTime>
-    <t:DaylightTime>
-      <t:Bias>-60</t:Bias>
-      <t:Time>02:00:00</t:Time>
-      <t:DayOrder>1</t:DayOrder>
-      <t:Month>4</t:Month>
-      <t:DayOfWeek>Sunday</t:DayOfWeek>
-    </t:DaylightTime>
-  </t:TimeZone>
-  <m:MailboxDataArray>
-    <t:MailboxData>
-      <t:Email>
-        <t:Name></t:Name>
-        <t:Address>someone@ExServer.example.com</t:Address>
-        <t:RoutingType>SMTP</t:RoutingType>
-      </t:Email>
-      <t:AttendeeType>Organizer</t:AttendeeType>
-      <t:ExcludeConflicts>false</t:ExcludeConflicts>
-    </t:MailboxData>
-  </m:MailboxDataArray>
-  <t:FreeBusyViewOptions>
-    <t:TimeWindow>
-      <t:StartTime>2006-02-06T00:00:00Z</t:StartTime>
-      <t:EndTime>2006-02-25T23:59:59Z</t:EndTime>
-    </t:TimeWindow>
-    <t:MergedFreeBusyIntervalInMinutes>60</t:MergedFreeBusyIntervalInMinutes>
-    <t:RequestedView>FreeBusyMerged</t:RequestedView>
-  </t:FreeBusyViewOptions>
-</m:GetUserAvailabilityRequest>`, string(xmlBytes))
-}
-
-func Test_unmarshal_GetUserAvailabilityResponse(t *testing.T) {
-
-	soapResp := `
-<?xml version="1.0" encoding="utf-8"?>
+func TestGetUserAvailability(t *testing.T) {
+	const soapResp = `<?xml version="1.0" encoding="utf-8"?>
 <s:Envelope
     xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
     <s:Header>
@@ -150,22 +62,49 @@ func Test_unmarshal_GetUserAvailabilityResponse(t *testing.T) {
     </s:Envelope>
 `
 
-	var resp getUserAvailabilityResponseEnvelop
-	err := xml.Unmarshal([]byte(soapResp), &resp)
-	if err != nil {
-		log.Fatal(err)
-	}
+	var reqBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		reqBody = string(data)
+		_, _ = w.Write([]byte(soapResp))
+	}))
+	defer srv.Close()
+
+	c, err := ews.NewClient(srv.URL, ews.Exchange2013)
+	assert.NoError(t, err)
+
+	start, _ := time.Parse(time.RFC3339, "2006-02-06T00:00:00Z")
+	end, _ := time.Parse(time.RFC3339, "2006-02-25T23:59:59Z")
 
-	assert.Equal(t,
-		ewsxml.ResponseClass_Error,
-		resp.Body.GetUserAvailabilityResponse.FreeBusyResponseArray.FreeBusyResponse[0].
-			ResponseMessage.ResponseClass,
-	)
+	op := GetUserAvailabilityOperation{GetUserAvailabilityRequest: ewsxml.GetUserAvailabilityRequest{
+		MailboxDataArray: []ewsxml.MailboxData{{
+			Email: ewsxml.Email{Address: "someone@ExServer.example.com", RoutingType: "SMTP"},
+		}},
+		FreeBusyViewOptions: &ewsxml.FreeBusyViewOptions{
+			TimeWindow:                      ewsxml.TimeWindow{StartTime: start, EndTime: end},
+			MergedFreeBusyIntervalInMinutes: 60,
+		},
+	}}
 
-	assert.Equal(t,
-		`Unable to resolve e-mail address someone@ExServer.example.com to an Active Directory object. LID: 57660`,
-		resp.Body.GetUserAvailabilityResponse.FreeBusyResponseArray.FreeBusyResponse[0].
-			ResponseMessage.MessageXml.ExceptionMessage,
-	)
+	resp, err := GetUserAvailability(context.Background(), c, &op)
+	assert.Contains(t, reqBody, `<m:MailboxDataArray><MailboxData><Email>`+
+		`<Address>someone@ExServer.example.com</Address><RoutingType>SMTP</RoutingType></Email>`+
+		`<AttendeeType>Required</AttendeeType></MailboxData></m:MailboxDataArray>`)
+	assert.Contains(t, reqBody, `<FreeBusyViewOptions><TimeWindow>`+
+		`<StartTime>2006-02-06T00:00:00Z</StartTime><EndTime>2006-02-25T23:59:59Z</EndTime></TimeWindow>`+
+		`<MergedFreeBusyIntervalInMinutes>60</MergedFreeBusyIntervalInMinutes>`+
+		`<RequestedView>FreeBusy</RequestedView></FreeBusyViewOptions>`)
 
+	var re *ews.ResponseError
+	if assert.True(t, errors.As(err, &re)) {
+		assert.Equal(t, ewsxml.ErrorMailRecipientNotFound, re.Response.Response().ResponseCode)
+	}
+	if assert.Len(t, resp.FreeBusyResponseArray, 1) {
+		msg := resp.FreeBusyResponseArray[0].ResponseMessage
+		assert.Equal(t, ewsxml.ResponseClass_Error, msg.ResponseClass)
+		assert.Equal(t,
+			`Unable to resolve e-mail address someone@ExServer.example.com to an Active Directory object. LID: 57660`,
+			msg.MessageXml.ExceptionMessage,
+		)
+	}
 }
@@ -0,0 +1,73 @@
+package ewsop
+
+import (
+	"context"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+// DefaultBatchSize is the default maximum number of ids which are sent in a
+// single request by GetItemBatched.
+const DefaultBatchSize = 100
+
+// GetItemBatched gets the items identified by op.GetItem, like GetItem, but
+// splits the ids in batches of at most batchSize ids, which are requested
+// sequentially. A batchSize <= 0 results in DefaultBatchSize. The response
+// messages of all batches are merged into a single GetItemResponse, in the
+// same order as the requested ids, where ItemId elements precede
+// OccurrenceItemId elements.
+// Response messages with an error, e.g. ErrorItemNotFound, do not stop the
+// remaining batches from being requested. Any other error does, in which case
+// the returned response contains the messages of the preceding batches.
+func GetItemBatched(ctx context.Context, req ews.Requester, op *GetItemOperation, batchSize int) (*GetItemResponse, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	ids, occIds := op.GetItem.ItemId, op.GetItem.OccurrenceItemId
+	total := len(ids) + len(occIds)
+
+	var out GetItemResponse
+	for start := 0; start < total; start += batchSize {
+		end := start + batchSize
+		if end > total {
+			end = total
+		}
+
+		batch := GetItemOperation{Header: op.Header, GetItem: op.GetItem}
+		batch.GetItem.ItemId = ids[min(start, len(ids)):min(end, len(ids))]
+		batch.GetItem.OccurrenceItemId = occIds[max(start-len(ids), 0):max(end-len(ids), 0)]
+
+		resp, err := GetItem(ctx, req, &batch)
+		out.ResponseMessages.GetItemResponseMessage = append(
+			out.ResponseMessages.GetItemResponseMessage,
+			resp.ResponseMessages.GetItemResponseMessage...,
+		)
+
+		var re *ews.ResponseError
+		if err != nil && !errors.As(err, &re) {
+			return &out, err
+		}
+	}
+
+	if out.Response().ResponseClass == ewsxml.ResponseClass_Error {
+		return &out, errors.WithStack(&ews.ResponseError{Response: &out})
+	}
+	return &out, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,69 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type getItemRequester struct {
+	batches [][]string
+}
+
+func (gr *getItemRequester) Request(req *ews.Request, out interface{}) error {
+	body := req.Body().(ewsxml.GetItem)
+
+	var ids []string
+	var resp strings.Builder
+	resp.WriteString(`<m:GetItemResponse><m:ResponseMessages>`)
+	for _, id := range body.ItemId {
+		ids = append(ids, id.Id)
+		if id.Id == "missing" {
+			resp.WriteString(`<m:GetItemResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorItemNotFound</m:ResponseCode><m:Items/></m:GetItemResponseMessage>`)
+			continue
+		}
+		fmt.Fprintf(&resp, `<m:GetItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode><m:Items><t:Message><t:ItemId Id="%s"/></t:Message></m:Items></m:GetItemResponseMessage>`, id.Id)
+	}
+	for _, id := range body.OccurrenceItemId {
+		ids = append(ids, id.RecurringMasterId)
+		fmt.Fprintf(&resp, `<m:GetItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode><m:Items><t:CalendarItem><t:ItemId Id="%s"/></t:CalendarItem></m:Items></m:GetItemResponseMessage>`, id.RecurringMasterId)
+	}
+	resp.WriteString(`</m:ResponseMessages></m:GetItemResponse>`)
+	gr.batches = append(gr.batches, ids)
+
+	if err := xml.Unmarshal([]byte(resp.String()), out); err != nil {
+		return err
+	}
+	if r := out.(ewsxml.Response); r.Response().ResponseClass == ewsxml.ResponseClass_Error {
+		return &ews.ResponseError{Response: r}
+	}
+	return nil
+}
+
+func TestGetItemBatched(t *testing.T) {
+	var op GetItemOperation
+	for _, id := range []string{"a", "b", "missing", "c"} {
+		op.GetItem.ItemId = append(op.GetItem.ItemId, ewsxml.ItemId{Id: id})
+	}
+	op.GetItem.OccurrenceItemId = []ewsxml.OccurrenceItemId{
+		{RecurringMasterId: "d", InstanceIndex: 1},
+	}
+
+	req := new(getItemRequester)
+	resp, err := GetItemBatched(context.Background(), req, &op, 3)
+	assert.True(t, errors.Is(err, ewsxml.ErrorItemNotFound))
+	assert.Equal(t, [][]string{{"a", "b", "missing"}, {"c", "d"}}, req.batches)
+
+	msgs := resp.ResponseMessages.GetItemResponseMessage
+	assert.Len(t, msgs, 5)
+	assert.Equal(t, ewsxml.ErrorItemNotFound, msgs[2].ResponseCode)
+	assert.Equal(t, []ewsxml.ItemId{{Id: "c"}}, msgs[3].Items.ItemIds())
+	assert.Equal(t, []ewsxml.ItemId{{Id: "d"}}, msgs[4].Items.ItemIds())
+}
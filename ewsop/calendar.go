@@ -10,11 +10,7 @@ import (
 
 type FindItemCalendarViewOperation struct {
 	Header   ewsxml.Header
-	FindItem struct {
-		ewsxml.FindItem
-		CalendarView    ewsxml.CalendarView
-		ParentFolderIds ewsxml.ParentFolderIds
-	}
+	FindItem ewsxml.FindItem
 }
 
 type FindItemCalendarViewResponse struct {
@@ -30,16 +26,24 @@ func (r *FindItemCalendarViewResponse) Response() *ewsxml.ResponseMessage {
 
 const OpGetCalendars Operation = "GetCalendars"
 
+// GetCalendars finds the calendar items within the CalendarView of the
+// calendar folder. Set the Mailbox of ParentFolderIds.DistinguishedFolderId to
+// find the items of another user's calendar, which the authenticated user has
+// delegate access to.
 func GetCalendars(ctx context.Context, req ews.Requester, op *FindItemCalendarViewOperation) (*FindItemCalendarViewResponse, error) {
 	ctx = setOperation(ctx, OpGetCalendars)
 
+	if err := validateFindItemView(&op.FindItem); err != nil {
+		return nil, err
+	}
+
 	if op.FindItem.Traversal == "" {
 		op.FindItem.Traversal = ewsxml.Traversal_Shallow
 	}
 	if op.FindItem.ItemShape.BaseShape == "" {
 		op.FindItem.ItemShape.BaseShape = ewsxml.BaseShape_Default
 	}
-	op.FindItem.ParentFolderIds.DistinguishedFolderId.Id = "calendar"
+	op.FindItem.ParentFolderIds.DistinguishedFolderId.Id = ewsxml.DistinguishedFolderId_Calendar
 
 	var out FindItemCalendarViewResponse
 	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.FindItem), &out)
@@ -0,0 +1,113 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/findconversation-operation
+type FindConversationOperation struct {
+	Header           ewsxml.Header
+	FindConversation ewsxml.FindConversation
+}
+
+type FindConversationResponse struct {
+	XMLName xml.Name `xml:"FindConversationResponse"`
+	ewsxml.ResponseMessage
+	Conversations []ewsxml.Conversation `xml:"Conversations>Conversation"`
+	// TotalConversationsInView is the total number of conversations in the
+	// folder, of which Conversations contains a single page.
+	TotalConversationsInView int
+	IndexedOffset            int
+}
+
+const OpFindConversation Operation = "FindConversation"
+
+func FindConversation(ctx context.Context, req ews.Requester, op *FindConversationOperation) (*FindConversationResponse, error) {
+	ctx = setOperation(ctx, OpFindConversation)
+
+	if v := op.FindConversation.IndexedPageItemView; v != nil && v.BasePoint == "" {
+		v.BasePoint = ewsxml.BasePoint_Beginning
+	}
+
+	var out FindConversationResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.FindConversation), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getconversationitems-operation
+type GetConversationItemsOperation struct {
+	Header               ewsxml.Header
+	GetConversationItems ewsxml.GetConversationItems
+}
+
+type GetConversationItemsResponse struct {
+	XMLName          xml.Name `xml:"GetConversationItemsResponse"`
+	ResponseMessages struct {
+		GetConversationItemsResponseMessage []ewsxml.GetConversationItemsResponseMessage
+	}
+}
+
+func (r *GetConversationItemsResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.GetConversationItemsResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// Conversations returns the nodes of each requested conversation, in the same
+// order as they were requested.
+func (r *GetConversationItemsResponse) Conversations() []ewsxml.ConversationResponse {
+	res := make([]ewsxml.ConversationResponse, 0, len(r.ResponseMessages.GetConversationItemsResponseMessage))
+	for _, msg := range r.ResponseMessages.GetConversationItemsResponseMessage {
+		res = append(res, msg.Conversation)
+	}
+	return res
+}
+
+const OpGetConversationItems Operation = "GetConversationItems"
+
+func GetConversationItems(ctx context.Context, req ews.Requester, op *GetConversationItemsOperation) (*GetConversationItemsResponse, error) {
+	ctx = setOperation(ctx, OpGetConversationItems)
+
+	if op.GetConversationItems.ItemShape.BaseShape == "" {
+		op.GetConversationItems.ItemShape.BaseShape = ewsxml.BaseShape_Default
+	}
+
+	var out GetConversationItemsResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.GetConversationItems), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/applyconversationaction-operation
+type ApplyConversationActionOperation struct {
+	Header                  ewsxml.Header
+	ApplyConversationAction ewsxml.ApplyConversationAction
+}
+
+type ApplyConversationActionResponse struct {
+	XMLName          xml.Name `xml:"ApplyConversationActionResponse"`
+	ResponseMessages struct {
+		ApplyConversationActionResponseMessage []ewsxml.ApplyConversationActionResponseMessage
+	}
+}
+
+func (r *ApplyConversationActionResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.ApplyConversationActionResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpApplyConversationAction Operation = "ApplyConversationAction"
+
+// ApplyConversationAction applies each of the ConversationActions of op. The
+// response contains a response message per action, in the same order.
+func ApplyConversationAction(ctx context.Context, req ews.Requester, op *ApplyConversationActionOperation) (*ApplyConversationActionResponse, error) {
+	var out ApplyConversationActionResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpApplyConversationAction), &op.Header, op.ApplyConversationAction),
+		&out,
+	)
+}
@@ -0,0 +1,73 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/convertid-operation
+type ConvertIdOperation struct {
+	Header    ewsxml.Header
+	ConvertId ewsxml.ConvertId
+}
+
+type ConvertIdResponse struct {
+	XMLName          xml.Name `xml:"ConvertIdResponse"`
+	ResponseMessages struct {
+		ConvertIdResponseMessage []ewsxml.ConvertIdResponseMessage
+	}
+}
+
+func (r *ConvertIdResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.ConvertIdResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// AlternateIds returns the converted id of each response message, in the
+// order of the request's SourceIds. The AlternateId of a failed conversion is
+// the zero value, its error is at the same index of Errors.
+func (r *ConvertIdResponse) AlternateIds() []ewsxml.AlternateId {
+	msgs := r.ResponseMessages.ConvertIdResponseMessage
+	res := make([]ewsxml.AlternateId, len(msgs))
+	for i, msg := range msgs {
+		if msg.ResponseClass != ewsxml.ResponseClass_Error {
+			res[i] = msg.AlternateId
+		}
+	}
+	return res
+}
+
+// Errors returns an *ews.ResponseError for each failed response message, in
+// the order of the request's SourceIds. The error of a successful conversion
+// is nil.
+func (r *ConvertIdResponse) Errors() []error {
+	msgs := r.ResponseMessages.ConvertIdResponseMessage
+	res := make([]error, len(msgs))
+	for i := range msgs {
+		if msgs[i].ResponseClass == ewsxml.ResponseClass_Error {
+			res[i] = &ews.ResponseError{Response: &msgs[i].ResponseMessage}
+		}
+	}
+	return res
+}
+
+const OpConvertId Operation = "ConvertId"
+
+// ConvertId converts the source ids, which may be of different formats, to
+// the destination format. It defaults to IdFormat_EwsId so the converted ids
+// can be used in other operations, like GetItem.
+func ConvertId(ctx context.Context, req ews.Requester, op *ConvertIdOperation) (*ConvertIdResponse, error) {
+	ctx = setOperation(ctx, OpConvertId)
+
+	if op.ConvertId.DestinationFormat == "" {
+		op.ConvertId.DestinationFormat = ewsxml.IdFormat_EwsId
+	}
+
+	var out ConvertIdResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.ConvertId), &out)
+}
@@ -0,0 +1,76 @@
+package ewsop
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertId(t *testing.T) {
+	const soapResp = `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:ConvertIdResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+        xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:ConvertIdResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:AlternateId xsi:type="t:AlternateIdType" Format="EwsId" Id="AAMkAGZhN2IxYTA0LWNiNzItN" Mailbox="user1@example.com"
+              xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" />
+        </m:ConvertIdResponseMessage>
+        <m:ConvertIdResponseMessage ResponseClass="Error">
+          <m:MessageText>Id is malformed.</m:MessageText>
+          <m:ResponseCode>ErrorInvalidIdMalformed</m:ResponseCode>
+          <m:DescriptiveLinkKey>0</m:DescriptiveLinkKey>
+        </m:ConvertIdResponseMessage>
+      </m:ResponseMessages>
+    </m:ConvertIdResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var reqBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		reqBody = string(data)
+		_, _ = w.Write([]byte(soapResp))
+	}))
+	defer srv.Close()
+
+	c, err := ews.NewClient(srv.URL, ews.Exchange2013)
+	assert.NoError(t, err)
+
+	op := ConvertIdOperation{ConvertId: ewsxml.ConvertId{SourceIds: []ewsxml.AlternateId{
+		{Format: ewsxml.IdFormat_EntryId, Id: "AAAAAGZhN2IxYTA0", Mailbox: "user1@example.com"},
+		{Format: ewsxml.IdFormat_EntryId, Id: "invalid", Mailbox: "user1@example.com"},
+	}}}
+
+	resp, err := ConvertId(context.Background(), c, &op)
+	assert.Contains(t, reqBody, `<m:ConvertId DestinationFormat="EwsId"><m:SourceIds>`+
+		`<AlternateId Format="EntryId" Id="AAAAAGZhN2IxYTA0" Mailbox="user1@example.com"></AlternateId>`+
+		`<AlternateId Format="EntryId" Id="invalid" Mailbox="user1@example.com"></AlternateId>`+
+		`</m:SourceIds></m:ConvertId>`)
+
+	var re *ews.ResponseError
+	assert.True(t, errors.As(err, &re))
+
+	// results are paired with the SourceIds, also when a conversion failed
+	assert.Equal(t, []ewsxml.AlternateId{
+		{Format: ewsxml.IdFormat_EwsId, Id: "AAMkAGZhN2IxYTA0LWNiNzItN", Mailbox: "user1@example.com"},
+		{},
+	}, resp.AlternateIds())
+
+	errs := resp.Errors()
+	if assert.Len(t, errs, 2) {
+		assert.NoError(t, errs[0])
+		if assert.True(t, errors.As(errs[1], &re)) {
+			assert.Equal(t, ewsxml.ErrorInvalidIdMalformed, re.Response.Response().ResponseCode)
+		}
+	}
+}
@@ -13,12 +13,142 @@ type CreateItemOperation struct {
 }
 
 type CreateItemResponse struct {
-	ResponseMessages []struct {
-		ewsxml.ResponseMessage
-		CalendarItems []struct {
-			ItemId ewsxml.ItemId
-		} `xml:"Items>CalendarItem"`
-	} `xml:"ResponseMessages>CreateItemResponseMessage"`
+	ResponseMessages []CreateItemResponseMessage `xml:"ResponseMessages>CreateItemResponseMessage"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createitemresponsemessage
+type CreateItemResponseMessage struct {
+	ewsxml.ResponseMessage
+	CalendarItems []struct {
+		ItemId ewsxml.ItemId
+	} `xml:"Items>CalendarItem"`
+	Messages []struct {
+		ItemId ewsxml.ItemId
+	} `xml:"Items>Message"`
+	MeetingCancellations []struct {
+		ItemId ewsxml.ItemId
+	} `xml:"Items>MeetingCancellation"`
+	Tasks []struct {
+		ItemId ewsxml.ItemId
+	} `xml:"Items>Task"`
+	PostItems []struct {
+		ItemId ewsxml.ItemId
+	} `xml:"Items>PostItem"`
+}
+
+// ItemIds returns the ItemId of each created item.
+func (m *CreateItemResponseMessage) ItemIds() []ewsxml.ItemId {
+	res := make([]ewsxml.ItemId, 0, len(m.CalendarItems)+len(m.Messages)+len(m.MeetingCancellations)+len(m.Tasks)+len(m.PostItems))
+	for _, x := range m.CalendarItems {
+		res = append(res, x.ItemId)
+	}
+	for _, x := range m.Messages {
+		res = append(res, x.ItemId)
+	}
+	for _, x := range m.MeetingCancellations {
+		res = append(res, x.ItemId)
+	}
+	for _, x := range m.Tasks {
+		res = append(res, x.ItemId)
+	}
+	for _, x := range m.PostItems {
+		res = append(res, x.ItemId)
+	}
+	return res
+}
+
+const OpCreateItem Operation = "CreateItem"
+
+// CreateItem creates the Items of op.CreateItem. Use AcceptItem,
+// TentativelyAcceptItem or DeclineItem to respond to a meeting request, and
+// ReplyToItem, ReplyAllToItem or ForwardItem to reply to or forward a message.
+func CreateItem(ctx context.Context, req ews.Requester, op *CreateItemOperation) (*CreateItemResponse, error) {
+	ctx = setOperation(ctx, OpCreateItem)
+
+	var out CreateItemResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.CreateItem), &out)
+}
+
+const (
+	OpAcceptItem            Operation = "AcceptItem"
+	OpTentativelyAcceptItem Operation = "TentativelyAcceptItem"
+	OpDeclineItem           Operation = "DeclineItem"
+	OpForwardItem           Operation = "ForwardItem"
+	OpReplyToItem           Operation = "ReplyToItem"
+	OpReplyAllToItem        Operation = "ReplyAllToItem"
+)
+
+// AcceptItem accepts the meeting requests referenced by the ReferenceItemId
+// of each AcceptItem. Unless set otherwise, the response is sent to the
+// organizer and a copy is saved in the Sent Items folder.
+func AcceptItem(ctx context.Context, req ews.Requester, op *CreateItemOperation, ai ...ewsxml.AcceptItem) (*CreateItemResponse, error) {
+	op = responseItemOperation(op)
+	op.CreateItem.Items.AcceptItem = append(op.CreateItem.Items.AcceptItem, ai...)
+	return sendResponseItems(setOperation(ctx, OpAcceptItem), req, op)
+}
+
+// TentativelyAcceptItem tentatively accepts the meeting requests referenced
+// by the ReferenceItemId of each TentativelyAcceptItem. Unless set otherwise,
+// the response is sent to the organizer and a copy is saved in the Sent Items
+// folder.
+func TentativelyAcceptItem(ctx context.Context, req ews.Requester, op *CreateItemOperation, ti ...ewsxml.TentativelyAcceptItem) (*CreateItemResponse, error) {
+	op = responseItemOperation(op)
+	op.CreateItem.Items.TentativelyAcceptItem = append(op.CreateItem.Items.TentativelyAcceptItem, ti...)
+	return sendResponseItems(setOperation(ctx, OpTentativelyAcceptItem), req, op)
+}
+
+// DeclineItem declines the meeting requests referenced by the ReferenceItemId
+// of each DeclineItem. Unless set otherwise, the response is sent to the
+// organizer and a copy is saved in the Sent Items folder.
+func DeclineItem(ctx context.Context, req ews.Requester, op *CreateItemOperation, di ...ewsxml.DeclineItem) (*CreateItemResponse, error) {
+	op = responseItemOperation(op)
+	op.CreateItem.Items.DeclineItem = append(op.CreateItem.Items.DeclineItem, di...)
+	return sendResponseItems(setOperation(ctx, OpDeclineItem), req, op)
+}
+
+// ForwardItem forwards the items referenced by the ReferenceItemId of each
+// ForwardItem. Unless set otherwise, it is sent and a copy is saved in the
+// Sent Items folder.
+func ForwardItem(ctx context.Context, req ews.Requester, op *CreateItemOperation, fi ...ewsxml.ForwardItem) (*CreateItemResponse, error) {
+	op = responseItemOperation(op)
+	op.CreateItem.Items.ForwardItem = append(op.CreateItem.Items.ForwardItem, fi...)
+	return sendResponseItems(setOperation(ctx, OpForwardItem), req, op)
+}
+
+// ReplyToItem replies to the sender of the items referenced by the
+// ReferenceItemId of each ReplyToItem. Unless set otherwise, the reply is
+// sent and a copy is saved in the Sent Items folder.
+func ReplyToItem(ctx context.Context, req ews.Requester, op *CreateItemOperation, ri ...ewsxml.ReplyToItem) (*CreateItemResponse, error) {
+	op = responseItemOperation(op)
+	op.CreateItem.Items.ReplyToItem = append(op.CreateItem.Items.ReplyToItem, ri...)
+	return sendResponseItems(setOperation(ctx, OpReplyToItem), req, op)
+}
+
+// ReplyAllToItem replies to the sender and all recipients of the items
+// referenced by the ReferenceItemId of each ReplyAllToItem. Unless set
+// otherwise, the reply is sent and a copy is saved in the Sent Items folder.
+func ReplyAllToItem(ctx context.Context, req ews.Requester, op *CreateItemOperation, ri ...ewsxml.ReplyAllToItem) (*CreateItemResponse, error) {
+	op = responseItemOperation(op)
+	op.CreateItem.Items.ReplyAllToItem = append(op.CreateItem.Items.ReplyAllToItem, ri...)
+	return sendResponseItems(setOperation(ctx, OpReplyAllToItem), req, op)
+}
+
+// responseItemOperation returns op, or a new CreateItemOperation when op is
+// nil, with MessageDisposition_SendAndSaveCopy as default
+// MessageDisposition so the response is actually sent.
+func responseItemOperation(op *CreateItemOperation) *CreateItemOperation {
+	if op == nil {
+		op = new(CreateItemOperation)
+	}
+	if op.CreateItem.MessageDisposition == "" {
+		op.CreateItem.MessageDisposition = ewsxml.MessageDisposition_SendAndSaveCopy
+	}
+	return op
+}
+
+func sendResponseItems(ctx context.Context, req ews.Requester, op *CreateItemOperation) (*CreateItemResponse, error) {
+	var out CreateItemResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.CreateItem), &out)
 }
 
 const OpCreateCalendarItem Operation = "CreateCalendarItem"
@@ -35,9 +165,10 @@ func CreateCalendarItem(ctx context.Context, req ews.Requester, op *CreateItemOp
 
 	if op.CreateItem.SavedItemFolderId.FolderId == nil {
 		if op.CreateItem.SavedItemFolderId.DistinguishedFolderId == nil {
-			op.CreateItem.SavedItemFolderId.DistinguishedFolderId = new(ewsxml.DistinguishedFolderId)
+			op.CreateItem.SavedItemFolderId = ewsxml.DistinguishedFolder(ewsxml.DistinguishedFolderId_Calendar)
+		} else {
+			op.CreateItem.SavedItemFolderId.DistinguishedFolderId.Id = ewsxml.DistinguishedFolderId_Calendar
 		}
-		op.CreateItem.SavedItemFolderId.DistinguishedFolderId.Id = "calendar"
 	}
 
 	op.CreateItem.Items.CalendarItem = append(op.CreateItem.Items.CalendarItem, ci...)
@@ -45,3 +176,28 @@ func CreateCalendarItem(ctx context.Context, req ews.Requester, op *CreateItemOp
 	var out CreateItemResponse
 	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.CreateItem), &out)
 }
+
+const OpCancelCalendarItem Operation = "CancelCalendarItem"
+
+// CancelCalendarItem cancels the meetings referenced by the ReferenceItemId
+// of each CancelCalendarItem and sends the cancellation to all attendees.
+// Unless set otherwise, a copy of the cancellation is saved in the Sent Items
+// folder.
+func CancelCalendarItem(ctx context.Context, req ews.Requester, op *CreateItemOperation, ci ...ewsxml.CancelCalendarItem) (*CreateItemResponse, error) {
+	ctx = setOperation(ctx, OpCancelCalendarItem)
+
+	if op == nil {
+		op = new(CreateItemOperation)
+	}
+	if op.CreateItem.MessageDisposition == "" {
+		op.CreateItem.MessageDisposition = ewsxml.MessageDisposition_SendAndSaveCopy
+	}
+	if op.CreateItem.SendMeetingInvitations == "" {
+		op.CreateItem.SendMeetingInvitations = ewsxml.SendMeetingInvitations_SendToAllAndSaveCopy
+	}
+
+	op.CreateItem.Items.CancelCalendarItem = append(op.CreateItem.Items.CancelCalendarItem, ci...)
+
+	var out CreateItemResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.CreateItem), &out)
+}
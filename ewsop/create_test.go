@@ -0,0 +1,39 @@
+package ewsop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateItem_MessageDisposition(t *testing.T) {
+	const resp = `<m:CreateItemResponse><m:ResponseMessages><m:CreateItemResponseMessage ResponseClass="Success">
+<m:ResponseCode>NoError</m:ResponseCode></m:CreateItemResponseMessage></m:ResponseMessages></m:CreateItemResponse>`
+
+	t.Run("draft", func(t *testing.T) {
+		op := CreateItemOperation{CreateItem: ewsxml.CreateItem{
+			MessageDisposition: ewsxml.MessageDisposition_SaveOnly,
+			Items:              ewsxml.Items{Message: []ewsxml.Message{{Subject: "draft"}}},
+		}}
+
+		_, err := CreateItem(context.Background(), &responsesRequester{responses: []string{resp}}, &op)
+		assert.NoError(t, err)
+		assert.Equal(t, ewsxml.MessageDisposition_SaveOnly, op.CreateItem.MessageDisposition)
+
+		op.CreateItem.MessageDisposition = ""
+		_, err = CreateItem(context.Background(), &responsesRequester{responses: []string{resp}}, &op)
+		assert.NoError(t, err)
+		assert.Empty(t, op.CreateItem.MessageDisposition)
+	})
+	t.Run("accept", func(t *testing.T) {
+		var op CreateItemOperation
+		accept := ewsxml.AcceptItem{ResponseObject: ewsxml.ResponseObject{ReferenceItemId: ewsxml.ItemId{Id: "AAMkAD"}}}
+
+		_, err := AcceptItem(context.Background(), &responsesRequester{responses: []string{resp}}, &op, accept)
+		assert.NoError(t, err)
+		assert.Equal(t, ewsxml.MessageDisposition_SendAndSaveCopy, op.CreateItem.MessageDisposition)
+		assert.Equal(t, []ewsxml.AcceptItem{accept}, op.CreateItem.Items.AcceptItem)
+	})
+}
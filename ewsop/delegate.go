@@ -0,0 +1,126 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// DelegateResponse contains the status of a delegate management request as a
+// whole, a response message for each of the delegates and how meeting
+// requests are delivered.
+type DelegateResponse struct {
+	ewsxml.ResponseMessage
+	ResponseMessages struct {
+		DelegateUserResponseMessageType []ewsxml.DelegateUserResponseMessage
+	}
+	DeliverMeetingRequests ewsxml.DeliverMeetingRequests
+}
+
+func (r *DelegateResponse) Response() *ewsxml.ResponseMessage {
+	if r.ResponseClass == ewsxml.ResponseClass_Error {
+		return &r.ResponseMessage
+	}
+
+	msgs := r.ResponseMessages.DelegateUserResponseMessageType
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// DelegateUsers returns the resulting set of delegates.
+func (r *DelegateResponse) DelegateUsers() []ewsxml.DelegateUser {
+	res := make([]ewsxml.DelegateUser, 0, len(r.ResponseMessages.DelegateUserResponseMessageType))
+	for _, msg := range r.ResponseMessages.DelegateUserResponseMessageType {
+		if msg.DelegateUser != nil {
+			res = append(res, *msg.DelegateUser)
+		}
+	}
+	return res
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getdelegate-operation
+type GetDelegateOperation struct {
+	Header      ewsxml.Header
+	GetDelegate ewsxml.GetDelegate
+}
+
+type GetDelegateResponse struct {
+	XMLName xml.Name `xml:"GetDelegateResponse"`
+	DelegateResponse
+}
+
+const OpGetDelegate Operation = "GetDelegate"
+
+func GetDelegate(ctx context.Context, req ews.Requester, op *GetDelegateOperation) (*GetDelegateResponse, error) {
+	var out GetDelegateResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpGetDelegate), &op.Header, op.GetDelegate),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/adddelegate-operation
+type AddDelegateOperation struct {
+	Header      ewsxml.Header
+	AddDelegate ewsxml.AddDelegate
+}
+
+type AddDelegateResponse struct {
+	XMLName xml.Name `xml:"AddDelegateResponse"`
+	DelegateResponse
+}
+
+const OpAddDelegate Operation = "AddDelegate"
+
+func AddDelegate(ctx context.Context, req ews.Requester, op *AddDelegateOperation) (*AddDelegateResponse, error) {
+	var out AddDelegateResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpAddDelegate), &op.Header, op.AddDelegate),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updatedelegate-operation
+type UpdateDelegateOperation struct {
+	Header         ewsxml.Header
+	UpdateDelegate ewsxml.UpdateDelegate
+}
+
+type UpdateDelegateResponse struct {
+	XMLName xml.Name `xml:"UpdateDelegateResponse"`
+	DelegateResponse
+}
+
+const OpUpdateDelegate Operation = "UpdateDelegate"
+
+func UpdateDelegate(ctx context.Context, req ews.Requester, op *UpdateDelegateOperation) (*UpdateDelegateResponse, error) {
+	var out UpdateDelegateResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpUpdateDelegate), &op.Header, op.UpdateDelegate),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/removedelegate-operation
+type RemoveDelegateOperation struct {
+	Header         ewsxml.Header
+	RemoveDelegate ewsxml.RemoveDelegate
+}
+
+type RemoveDelegateResponse struct {
+	XMLName xml.Name `xml:"RemoveDelegateResponse"`
+	DelegateResponse
+}
+
+const OpRemoveDelegate Operation = "RemoveDelegate"
+
+func RemoveDelegate(ctx context.Context, req ews.Requester, op *RemoveDelegateOperation) (*RemoveDelegateResponse, error) {
+	var out RemoveDelegateResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpRemoveDelegate), &op.Header, op.RemoveDelegate),
+		&out,
+	)
+}
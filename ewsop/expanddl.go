@@ -0,0 +1,41 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/expanddl-operation
+type ExpandDLOperation struct {
+	Header   ewsxml.Header
+	ExpandDL ewsxml.ExpandDL
+}
+
+type ExpandDLResponse struct {
+	XMLName          xml.Name `xml:"ExpandDLResponse"`
+	ResponseMessages struct {
+		ExpandDLResponseMessage ewsxml.ExpandDLResponseMessage
+	}
+}
+
+func (r *ExpandDLResponse) Response() *ewsxml.ResponseMessage {
+	return r.ResponseMessages.ExpandDLResponseMessage.Response()
+}
+
+// Members returns the members of the expanded distribution list.
+func (r *ExpandDLResponse) Members() []ewsxml.Mailbox {
+	return r.ResponseMessages.ExpandDLResponseMessage.DLExpansion.Mailbox
+}
+
+const OpExpandDL Operation = "ExpandDL"
+
+func ExpandDL(ctx context.Context, req ews.Requester, op *ExpandDLOperation) (*ExpandDLResponse, error) {
+	var out ExpandDLResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpExpandDL), &op.Header, op.ExpandDL),
+		&out,
+	)
+}
@@ -0,0 +1,123 @@
+package ewsop
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/exportitems-operation
+type ExportItemsOperation struct {
+	Header      ewsxml.Header
+	ExportItems ewsxml.ExportItems
+}
+
+// ExportItemsResponse contains an ExportItemsResponseMessage for each item
+// in the request, in the same order.
+type ExportItemsResponse struct {
+	XMLName          xml.Name `xml:"ExportItemsResponse"`
+	ResponseMessages struct {
+		ExportItemsResponseMessage []ewsxml.ExportItemsResponseMessage
+	}
+}
+
+func (r *ExportItemsResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.ExportItemsResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpExportItems Operation = "ExportItems"
+
+// ExportItems exports the items identified by op.ExportItems.ItemIds. The
+// complete response is buffered in memory, use ExportItemData to export large
+// items.
+func ExportItems(ctx context.Context, req ews.Requester, op *ExportItemsOperation) (*ExportItemsResponse, error) {
+	var out ExportItemsResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpExportItems), &op.Header, op.ExportItems),
+		&out,
+	)
+}
+
+const ErrNoExportData errors.Msg = "response does not contain exported item data"
+
+// ExportItemData exports the item identified by id and returns a reader which
+// decodes its base64 Data while it is being read from the response. This
+// prevents large items from being buffered in memory. The returned
+// io.ReadCloser must be closed by the caller. A failed response is returned
+// as an *ews.ResponseError.
+func ExportItemData(ctx context.Context, req ews.StreamRequester, head *ewsxml.Header, id ewsxml.ItemId) (io.ReadCloser, error) {
+	body, err := req.Stream(ews.NewRequest(
+		setOperation(ctx, OpExportItems),
+		head,
+		ewsxml.ExportItems{ItemIds: []ewsxml.ItemId{id}},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(body)
+	if err = skipToElement(br, "Data", ErrNoExportData); err != nil {
+		errors.AppendFunc(&err, body.Close)
+		return nil, err
+	}
+
+	return &contentReader{
+		Reader: base64.NewDecoder(base64.StdEncoding, &charDataReader{r: br}),
+		Closer: body,
+	}, nil
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/uploaditems-operation
+type UploadItemsOperation struct {
+	Header      ewsxml.Header
+	UploadItems ewsxml.UploadItems
+}
+
+// UploadItemsResponse contains an UploadItemsResponseMessage for each item
+// in the request, in the same order.
+type UploadItemsResponse struct {
+	XMLName          xml.Name `xml:"UploadItemsResponse"`
+	ResponseMessages struct {
+		UploadItemsResponseMessage []ewsxml.UploadItemsResponseMessage
+	}
+}
+
+func (r *UploadItemsResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.UploadItemsResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// ItemIds returns the ItemId of each uploaded item.
+func (r *UploadItemsResponse) ItemIds() []ewsxml.ItemId {
+	var res []ewsxml.ItemId
+	for _, msg := range r.ResponseMessages.UploadItemsResponseMessage {
+		if msg.ItemId.Id != "" {
+			res = append(res, msg.ItemId)
+		}
+	}
+	return res
+}
+
+const OpUploadItems Operation = "UploadItems"
+
+// UploadItems imports the items of op.UploadItems. Requests are marshalled in
+// memory before they are sent, so items should be uploaded in batches to
+// limit memory usage.
+func UploadItems(ctx context.Context, req ews.Requester, op *UploadItemsOperation) (*UploadItemsResponse, error) {
+	var out UploadItemsResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpUploadItems), &op.Header, op.UploadItems),
+		&out,
+	)
+}
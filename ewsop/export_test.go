@@ -0,0 +1,47 @@
+package ewsop
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportItemData(t *testing.T) {
+	t.Run("data", func(t *testing.T) {
+		req := &streamRequester{body: `<s:Envelope><s:Body><m:ExportItemsResponse><m:ResponseMessages>
+<m:ExportItemsResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+<m:ItemId Id="AAMkAD" ChangeKey="CQAAAB"/><m:Data>AQAAAAgAAAAA
+AAAA</m:Data></m:ExportItemsResponseMessage>
+</m:ResponseMessages></m:ExportItemsResponse></s:Body></s:Envelope>`}
+
+		r, err := ExportItemData(context.Background(), req, nil, ewsxml.ItemId{Id: "AAMkAD"})
+		assert.NoError(t, err)
+
+		data, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0}, data)
+		assert.NoError(t, r.Close())
+	})
+	t.Run("no data", func(t *testing.T) {
+		req := &streamRequester{body: `<s:Envelope><s:Body></s:Body></s:Envelope>`}
+
+		_, err := ExportItemData(context.Background(), req, nil, ewsxml.ItemId{Id: "AAMkAD"})
+		assert.True(t, errors.Is(err, ErrNoExportData))
+	})
+	t.Run("error response", func(t *testing.T) {
+		req := &streamRequester{body: `<s:Envelope><s:Body><m:ExportItemsResponse><m:ResponseMessages>
+<m:ExportItemsResponseMessage ResponseClass="Error"><m:MessageText>Access is denied. Check credentials and try again.</m:MessageText>
+<m:ResponseCode>ErrorAccessDenied</m:ResponseCode><m:DescriptiveLinkKey>0</m:DescriptiveLinkKey>
+</m:ExportItemsResponseMessage></m:ResponseMessages></m:ExportItemsResponse></s:Body></s:Envelope>`}
+
+		_, err := ExportItemData(context.Background(), req, nil, ewsxml.ItemId{Id: "AAMkAD"})
+		code, ok := ews.ResponseCodeOf(err)
+		assert.True(t, ok)
+		assert.Equal(t, ewsxml.ErrorAccessDenied, code)
+	})
+}
@@ -0,0 +1,100 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+const (
+	ErrMultipleViews errors.Msg = "only one view may be set per FindItem request"
+	ErrNoMorePages   errors.Msg = "no more pages"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/finditem-operation
+type FindItemOperation struct {
+	Header   ewsxml.Header
+	FindItem ewsxml.FindItem
+}
+
+type FindItemResponse struct {
+	XMLName          xml.Name `xml:"FindItemResponse"`
+	ResponseMessages struct {
+		FindItemResponseMessage ewsxml.FindItemResponseMessage
+	}
+}
+
+func (r *FindItemResponse) Response() *ewsxml.ResponseMessage {
+	return r.ResponseMessages.FindItemResponseMessage.Response()
+}
+
+// RootFolder returns the RootFolder containing the found items.
+func (r *FindItemResponse) RootFolder() *ewsxml.RootFolder {
+	return &r.ResponseMessages.FindItemResponseMessage.RootFolder
+}
+
+const OpFindItem Operation = "FindItem"
+
+// FindItem finds the items within the folder of ParentFolderIds, which
+// defaults to the inbox. Use one of the paging views to page through large
+// folders.
+func FindItem(ctx context.Context, req ews.Requester, op *FindItemOperation) (*FindItemResponse, error) {
+	ctx = setOperation(ctx, OpFindItem)
+
+	if err := validateFindItemView(&op.FindItem); err != nil {
+		return nil, err
+	}
+	if v := op.FindItem.IndexedPageItemView; v != nil && v.BasePoint == "" {
+		v.BasePoint = ewsxml.BasePoint_Beginning
+	}
+	if v := op.FindItem.FractionalPageItemView; v != nil && v.Denominator == 0 {
+		v.Denominator = 1
+	}
+
+	if op.FindItem.Traversal == "" {
+		op.FindItem.Traversal = ewsxml.Traversal_Shallow
+	}
+	if op.FindItem.ItemShape.BaseShape == "" {
+		op.FindItem.ItemShape.BaseShape = ewsxml.BaseShape_Default
+	}
+	if op.FindItem.ParentFolderIds.DistinguishedFolderId.Id == "" {
+		op.FindItem.ParentFolderIds.DistinguishedFolderId.Id = ewsxml.DistinguishedFolderId_Inbox
+	}
+
+	var out FindItemResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.FindItem), &out)
+}
+
+// FindMessages runs a FindItem operation and returns the found messages. A
+// nil op finds the messages in the inbox.
+func FindMessages(ctx context.Context, req ews.Requester, op *FindItemOperation) ([]ewsxml.Message, error) {
+	if op == nil {
+		op = new(FindItemOperation)
+	}
+
+	resp, err := FindItem(ctx, req, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ResponseMessages.FindItemResponseMessage.Messages(), nil
+}
+
+func validateFindItemView(f *ewsxml.FindItem) error {
+	var n int
+	if f.IndexedPageItemView != nil {
+		n++
+	}
+	if f.FractionalPageItemView != nil {
+		n++
+	}
+	if f.CalendarView != nil {
+		n++
+	}
+	if n > 1 {
+		return errors.New(ErrMultipleViews)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package ewsop
+
+import (
+	"context"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+// DefaultPageSize is the default maximum number of items per page of a
+// FindItemPager.
+const DefaultPageSize = 100
+
+// FindItemPager pages through the results of a FindItem operation using an
+// IndexedPageItemView.
+//
+//	pager := NewFindItemPager(client, op, 50)
+//	for pager.More() {
+//		resp, err := pager.Next(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		// process resp.RootFolder().Items
+//	}
+type FindItemPager struct {
+	req  ews.Requester
+	op   FindItemOperation
+	done bool
+}
+
+// NewFindItemPager creates a FindItemPager which requests pages of at most
+// pageSize items, or DefaultPageSize when pageSize <= 0. An
+// IndexedPageItemView of op is used as starting point, any other view is
+// replaced by it.
+func NewFindItemPager(req ews.Requester, op FindItemOperation, pageSize int) *FindItemPager {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	view := ewsxml.IndexedPageItemView{BasePoint: ewsxml.BasePoint_Beginning}
+	if op.FindItem.IndexedPageItemView != nil {
+		view = *op.FindItem.IndexedPageItemView
+	}
+	view.MaxEntriesReturned = pageSize
+
+	op.FindItem.IndexedPageItemView = &view
+	op.FindItem.FractionalPageItemView = nil
+	op.FindItem.CalendarView = nil
+	return &FindItemPager{req: req, op: op}
+}
+
+// More indicates whether there are more pages to request.
+func (p *FindItemPager) More() bool { return !p.done }
+
+// Next requests the next page and advances the offset of the pager. No more
+// pages are requested after the page which includes the last item, or after
+// an error has occurred.
+func (p *FindItemPager) Next(ctx context.Context) (*FindItemResponse, error) {
+	if p.done {
+		return nil, errors.New(ErrNoMorePages)
+	}
+	if err := ctx.Err(); err != nil {
+		p.done = true
+		return nil, errors.WithStack(err)
+	}
+
+	op := p.op
+	view := *p.op.FindItem.IndexedPageItemView
+	op.FindItem.IndexedPageItemView = &view
+
+	resp, err := FindItem(ctx, p.req, &op)
+	if err != nil {
+		p.done = true
+		return resp, err
+	}
+
+	root := resp.RootFolder()
+	if root.IncludesLastItemInRange || root.Items.Len() == 0 {
+		p.done = true
+	}
+	p.op.FindItem.IndexedPageItemView.Offset = root.IndexedPagingOffset
+	return resp, nil
+}
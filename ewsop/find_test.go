@@ -0,0 +1,132 @@
+package ewsop
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindItem(t *testing.T) {
+	t.Run("multiple views", func(t *testing.T) {
+		op := FindItemOperation{FindItem: ewsxml.FindItem{
+			IndexedPageItemView: &ewsxml.IndexedPageItemView{MaxEntriesReturned: 10},
+			CalendarView:        &ewsxml.CalendarView{StartDate: ewsxml.NewEwsDateTime(time.Now()), EndDate: ewsxml.NewEwsDateTime(time.Now())},
+		}}
+
+		_, err := FindItem(context.Background(), nil, &op)
+		assert.ErrorIs(t, err, ErrMultipleViews)
+
+		op.FindItem.CalendarView = nil
+		op.FindItem.FractionalPageItemView = &ewsxml.FractionalPageItemView{Numerator: 1, Denominator: 2}
+		_, err = FindItem(context.Background(), nil, &op)
+		assert.ErrorIs(t, err, ErrMultipleViews)
+	})
+	t.Run("paging", func(t *testing.T) {
+		req := &responsesRequester{responses: []string{`<m:FindItemResponse><m:ResponseMessages>
+<m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+<m:RootFolder IndexedPagingOffset="10" TotalItemsInView="25" IncludesLastItemInRange="false">
+<t:Items><t:Message><t:ItemId Id="AAMkAD" ChangeKey="CQAAAB" /></t:Message></t:Items>
+</m:RootFolder></m:FindItemResponseMessage></m:ResponseMessages></m:FindItemResponse>`}}
+
+		op := FindItemOperation{FindItem: ewsxml.FindItem{
+			IndexedPageItemView: &ewsxml.IndexedPageItemView{MaxEntriesReturned: 10},
+		}}
+
+		resp, err := FindItem(context.Background(), req, &op)
+		assert.NoError(t, err)
+		assert.Equal(t, ewsxml.BasePoint_Beginning, op.FindItem.IndexedPageItemView.BasePoint)
+		assert.Equal(t, 10, resp.RootFolder().IndexedPagingOffset)
+		assert.False(t, resp.RootFolder().IncludesLastItemInRange)
+		assert.Len(t, resp.RootFolder().Items.Message, 1)
+	})
+}
+
+func TestFindMessages(t *testing.T) {
+	req := &responsesRequester{responses: []string{`<m:FindItemResponse><m:ResponseMessages>
+<m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+<m:RootFolder TotalItemsInView="2" IncludesLastItemInRange="true">
+<t:Items><t:Message><t:ItemId Id="a"/><t:Subject>First</t:Subject></t:Message><t:Message><t:ItemId Id="b"/></t:Message></t:Items>
+</m:RootFolder></m:FindItemResponseMessage></m:ResponseMessages></m:FindItemResponse>`}}
+
+	have, err := FindMessages(context.Background(), req, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, have, 2) {
+		assert.Equal(t, "a", have[0].ItemId.Id)
+		assert.Equal(t, "First", have[0].Subject)
+		assert.Equal(t, "b", have[1].ItemId.Id)
+	}
+}
+
+func TestFindItemPager(t *testing.T) {
+	const resp = `<m:FindItemResponse><m:ResponseMessages>
+<m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+<m:RootFolder IndexedPagingOffset="%d" TotalItemsInView="3" IncludesLastItemInRange="%t">
+<t:Items>%s</t:Items></m:RootFolder></m:FindItemResponseMessage></m:ResponseMessages></m:FindItemResponse>`
+
+	t.Run("pages", func(t *testing.T) {
+		req := &responsesRequester{responses: []string{
+			fmt.Sprintf(resp, 2, false, `<t:Message><t:ItemId Id="a"/></t:Message><t:Message><t:ItemId Id="b"/></t:Message>`),
+			fmt.Sprintf(resp, 3, true, `<t:Message><t:ItemId Id="c"/></t:Message>`),
+		}}
+
+		pager := NewFindItemPager(req, FindItemOperation{}, 2)
+		var ids []ewsxml.ItemId
+		for pager.More() {
+			resp, err := pager.Next(context.Background())
+			assert.NoError(t, err)
+			ids = append(ids, resp.RootFolder().Items.ItemIds()...)
+		}
+
+		assert.Equal(t, []ewsxml.ItemId{{Id: "a"}, {Id: "b"}, {Id: "c"}}, ids)
+		assert.Equal(t, 3, pager.op.FindItem.IndexedPageItemView.Offset)
+		assert.Empty(t, req.responses)
+
+		_, err := pager.Next(context.Background())
+		assert.ErrorIs(t, err, ErrNoMorePages)
+	})
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		pager := NewFindItemPager(&responsesRequester{}, FindItemOperation{}, 0)
+		assert.Equal(t, DefaultPageSize, pager.op.FindItem.IndexedPageItemView.MaxEntriesReturned)
+
+		_, err := pager.Next(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, pager.More())
+	})
+}
+
+func TestFindPeople(t *testing.T) {
+	req := &responsesRequester{responses: []string{`<m:FindPeopleResponse ResponseClass="Success">
+<m:ResponseCode>NoError</m:ResponseCode>
+<m:People>
+<t:Persona><t:PersonaId Id="AAUQAF" /><t:DisplayName>Jane Doe</t:DisplayName></t:Persona>
+<t:Persona><t:PersonaId Id="AAUQAG" /><t:DisplayName>Jane Roe</t:DisplayName></t:Persona>
+</m:People>
+<m:TotalNumberOfPeopleInView>12</m:TotalNumberOfPeopleInView>
+<m:FirstMatchingRowIndex>0</m:FirstMatchingRowIndex>
+<m:FirstLoadedRowIndex>0</m:FirstLoadedRowIndex>
+</m:FindPeopleResponse>`}}
+
+	query := "jane"
+	op := FindPeopleOperation{FindPeople: ewsxml.FindPeople{
+		PersonaShape:          &ewsxml.PersonaShape{},
+		IndexedPageItemView:   ewsxml.IndexedPageItemView{MaxEntriesReturned: 2},
+		DistinguishedFolderId: &ewsxml.DistinguishedFolderId{Id: ewsxml.DistinguishedFolderId_Contacts},
+		QueryString:           &query,
+	}}
+
+	resp, err := FindPeople(context.Background(), req, &op)
+	assert.NoError(t, err)
+	assert.Equal(t, ewsxml.BaseShape_Default, op.FindPeople.PersonaShape.BaseShape)
+	assert.Equal(t, ewsxml.BasePoint_Beginning, op.FindPeople.IndexedPageItemView.BasePoint)
+	assert.Equal(t, ewsxml.ResponseClass_Success, resp.ResponseClass)
+	assert.Len(t, resp.People, 2)
+	assert.Equal(t, "Jane Roe", resp.People[1].DisplayName)
+	assert.Equal(t, 12, resp.TotalNumberOfPeopleInView)
+}
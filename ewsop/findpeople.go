@@ -16,6 +16,11 @@ type FindPeopleOperation struct {
 type FindPeopleResponse struct {
 	ewsxml.ResponseMessage
 	People []ewsxml.Persona `xml:"People>Persona"`
+	// TotalNumberOfPeopleInView is the total number of personas which match
+	// the request, of which People contains a single page.
+	TotalNumberOfPeopleInView int
+	FirstMatchingRowIndex     int
+	FirstLoadedRowIndex       int
 }
 
 const OpFindPeople Operation = "FindPeople"
@@ -0,0 +1,324 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getfolder-operation
+type GetFolderOperation struct {
+	Header    ewsxml.Header
+	GetFolder ewsxml.GetFolder
+}
+
+type GetFolderResponse struct {
+	XMLName          xml.Name `xml:"GetFolderResponse"`
+	ResponseMessages struct {
+		GetFolderResponseMessage []ewsxml.GetFolderResponseMessage
+	}
+}
+
+func (r *GetFolderResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.GetFolderResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpGetFolder Operation = "GetFolder"
+
+func GetFolder(ctx context.Context, req ews.Requester, op *GetFolderOperation) (*GetFolderResponse, error) {
+	ctx = setOperation(ctx, OpGetFolder)
+
+	if op.GetFolder.FolderShape.BaseShape == "" {
+		op.GetFolder.FolderShape.BaseShape = ewsxml.BaseShape_Default
+	}
+
+	var out GetFolderResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.GetFolder), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createfolder-operation
+type CreateFolderOperation struct {
+	Header       ewsxml.Header
+	CreateFolder ewsxml.CreateFolder
+}
+
+// CreateFolderResponse contains a CreateFolderResponseMessage for each folder
+// in the request, in the same order.
+type CreateFolderResponse struct {
+	XMLName          xml.Name `xml:"CreateFolderResponse"`
+	ResponseMessages struct {
+		CreateFolderResponseMessage []ewsxml.CreateFolderResponseMessage
+	}
+}
+
+func (r *CreateFolderResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.CreateFolderResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// FolderIds returns the FolderId of each created folder.
+func (r *CreateFolderResponse) FolderIds() []ewsxml.FolderId {
+	var res []ewsxml.FolderId
+	for _, msg := range r.ResponseMessages.CreateFolderResponseMessage {
+		res = append(res, msg.Folders.FolderIds()...)
+	}
+	return res
+}
+
+const OpCreateFolder Operation = "CreateFolder"
+
+// CreateFolder creates the folders in op.CreateFolder. Each folder should have
+// at least a DisplayName.
+func CreateFolder(ctx context.Context, req ews.Requester, op *CreateFolderOperation, f ...ewsxml.Folder) (*CreateFolderResponse, error) {
+	ctx = setOperation(ctx, OpCreateFolder)
+
+	if op.CreateFolder.FolderId == nil && op.CreateFolder.DistinguishedFolderId == nil {
+		op.CreateFolder.DistinguishedFolderId = new(ewsxml.DistinguishedFolderId).
+			WithId(ewsxml.DistinguishedFolderId_MsgFolderRoot)
+	}
+
+	op.CreateFolder.Folders.Folder = append(op.CreateFolder.Folders.Folder, f...)
+
+	var out CreateFolderResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.CreateFolder), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deletefolder-operation
+type DeleteFolderOperation struct {
+	Header       ewsxml.Header
+	DeleteFolder ewsxml.DeleteFolder
+}
+
+// DeleteFolderResponse contains a ResponseMessage for each folder in the
+// request, in the same order. A folder which cannot be deleted, e.g. because
+// its id is invalid, does not affect the other folders in the request.
+type DeleteFolderResponse struct {
+	XMLName          xml.Name                 `xml:"DeleteFolderResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>DeleteFolderResponseMessage"`
+}
+
+func (r *DeleteFolderResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpDeleteFolder Operation = "DeleteFolder"
+
+func DeleteFolder(ctx context.Context, req ews.Requester, op *DeleteFolderOperation) (*DeleteFolderResponse, error) {
+	ctx = setOperation(ctx, OpDeleteFolder)
+
+	if op.DeleteFolder.DeleteType == "" {
+		op.DeleteFolder.DeleteType = ewsxml.DeleteType_MoveToDeletedItems
+	}
+
+	var out DeleteFolderResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.DeleteFolder), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updatefolder-operation
+type UpdateFolderOperation struct {
+	Header       ewsxml.Header
+	UpdateFolder ewsxml.UpdateFolder
+}
+
+type UpdateFolderResponse struct {
+	XMLName          xml.Name `xml:"UpdateFolderResponse"`
+	ResponseMessages struct {
+		UpdateFolderResponseMessage []ewsxml.UpdateFolderResponseMessage
+	}
+}
+
+func (r *UpdateFolderResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.UpdateFolderResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// FolderIds returns the FolderId, including the new ChangeKey, of each
+// updated folder.
+func (r *UpdateFolderResponse) FolderIds() []ewsxml.FolderId {
+	var res []ewsxml.FolderId
+	for _, msg := range r.ResponseMessages.UpdateFolderResponseMessage {
+		res = append(res, msg.Folders.FolderIds()...)
+	}
+	return res
+}
+
+const OpUpdateFolder Operation = "UpdateFolder"
+
+func UpdateFolder(ctx context.Context, req ews.Requester, op *UpdateFolderOperation) (*UpdateFolderResponse, error) {
+	var out UpdateFolderResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpUpdateFolder), &op.Header, op.UpdateFolder),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/movefolder-operation
+type MoveFolderOperation struct {
+	Header     ewsxml.Header
+	MoveFolder ewsxml.MoveFolder
+}
+
+type MoveFolderResponse struct {
+	XMLName          xml.Name `xml:"MoveFolderResponse"`
+	ResponseMessages struct {
+		MoveFolderResponseMessage []ewsxml.MoveFolderResponseMessage
+	}
+}
+
+func (r *MoveFolderResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.MoveFolderResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpMoveFolder Operation = "MoveFolder"
+
+func MoveFolder(ctx context.Context, req ews.Requester, op *MoveFolderOperation) (*MoveFolderResponse, error) {
+	var out MoveFolderResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpMoveFolder), &op.Header, op.MoveFolder),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyfolder-operation
+type CopyFolderOperation struct {
+	Header     ewsxml.Header
+	CopyFolder ewsxml.CopyFolder
+}
+
+type CopyFolderResponse struct {
+	XMLName          xml.Name `xml:"CopyFolderResponse"`
+	ResponseMessages struct {
+		CopyFolderResponseMessage []ewsxml.CopyFolderResponseMessage
+	}
+}
+
+func (r *CopyFolderResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.CopyFolderResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpCopyFolder Operation = "CopyFolder"
+
+func CopyFolder(ctx context.Context, req ews.Requester, op *CopyFolderOperation) (*CopyFolderResponse, error) {
+	var out CopyFolderResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpCopyFolder), &op.Header, op.CopyFolder),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/findfolder-operation
+type FindFolderOperation struct {
+	Header     ewsxml.Header
+	FindFolder ewsxml.FindFolder
+}
+
+type FindFolderResponse struct {
+	XMLName          xml.Name `xml:"FindFolderResponse"`
+	ResponseMessages struct {
+		FindFolderResponseMessage []ewsxml.FindFolderResponseMessage
+	}
+}
+
+func (r *FindFolderResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.FindFolderResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpFindFolder Operation = "FindFolder"
+
+func FindFolder(ctx context.Context, req ews.Requester, op *FindFolderOperation) (*FindFolderResponse, error) {
+	ctx = setOperation(ctx, OpFindFolder)
+
+	if op.FindFolder.Traversal == "" {
+		op.FindFolder.Traversal = ewsxml.Traversal_Shallow
+	}
+	if op.FindFolder.FolderShape.BaseShape == "" {
+		op.FindFolder.FolderShape.BaseShape = ewsxml.BaseShape_Default
+	}
+	if op.FindFolder.IndexedPageFolderView != nil && op.FindFolder.IndexedPageFolderView.BasePoint == "" {
+		op.FindFolder.IndexedPageFolderView.BasePoint = ewsxml.BasePoint_Beginning
+	}
+
+	var out FindFolderResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.FindFolder), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/emptyfolder-operation
+type EmptyFolderOperation struct {
+	Header      ewsxml.Header
+	EmptyFolder ewsxml.EmptyFolder
+}
+
+// EmptyFolderResponse contains a ResponseMessage for each folder in the
+// request, in the same order.
+type EmptyFolderResponse struct {
+	XMLName          xml.Name                 `xml:"EmptyFolderResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>EmptyFolderResponseMessage"`
+}
+
+func (r *EmptyFolderResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpEmptyFolder Operation = "EmptyFolder"
+
+func EmptyFolder(ctx context.Context, req ews.Requester, op *EmptyFolderOperation) (*EmptyFolderResponse, error) {
+	ctx = setOperation(ctx, OpEmptyFolder)
+
+	if op.EmptyFolder.DeleteType == "" {
+		op.EmptyFolder.DeleteType = ewsxml.DeleteType_MoveToDeletedItems
+	}
+
+	var out EmptyFolderResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.EmptyFolder), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/markallitemsasread-operation
+type MarkAllItemsAsReadOperation struct {
+	Header             ewsxml.Header
+	MarkAllItemsAsRead ewsxml.MarkAllItemsAsRead
+}
+
+// MarkAllItemsAsReadResponse contains a ResponseMessage for each folder in
+// the request, in the same order.
+type MarkAllItemsAsReadResponse struct {
+	XMLName          xml.Name                 `xml:"MarkAllItemsAsReadResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>MarkAllItemsAsReadResponseMessage"`
+}
+
+func (r *MarkAllItemsAsReadResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpMarkAllItemsAsRead Operation = "MarkAllItemsAsRead"
+
+func MarkAllItemsAsRead(ctx context.Context, req ews.Requester, op *MarkAllItemsAsReadOperation) (*MarkAllItemsAsReadResponse, error) {
+	var out MarkAllItemsAsReadResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpMarkAllItemsAsRead), &op.Header, op.MarkAllItemsAsRead),
+		&out,
+	)
+}
@@ -0,0 +1,260 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getitem-operation
+type GetItemOperation struct {
+	Header  ewsxml.Header
+	GetItem ewsxml.GetItem
+}
+
+type GetItemResponse struct {
+	XMLName          xml.Name `xml:"GetItemResponse"`
+	ResponseMessages struct {
+		GetItemResponseMessage []ewsxml.GetItemResponseMessage
+	}
+}
+
+func (r *GetItemResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.GetItemResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpGetItem Operation = "GetItem"
+
+// GetItem gets one or more items, identified by the ItemId and
+// OccurrenceItemId elements of op.GetItem, from the Exchange store. The
+// response messages are in the same order as the requested ids.
+func GetItem(ctx context.Context, req ews.Requester, op *GetItemOperation) (*GetItemResponse, error) {
+	ctx = setOperation(ctx, OpGetItem)
+
+	if op.GetItem.ItemShape.BaseShape == "" {
+		op.GetItem.ItemShape.BaseShape = ewsxml.BaseShape_Default
+	}
+
+	var out GetItemResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.GetItem), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateitem-operation
+type UpdateItemOperation struct {
+	Header     ewsxml.Header
+	UpdateItem ewsxml.UpdateItem
+}
+
+type UpdateItemResponse struct {
+	XMLName          xml.Name `xml:"UpdateItemResponse"`
+	ResponseMessages struct {
+		UpdateItemResponseMessage []ewsxml.UpdateItemResponseMessage
+	}
+}
+
+func (r *UpdateItemResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.UpdateItemResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// ItemIds returns the ItemId, including the new ChangeKey, of all updated
+// items.
+func (r *UpdateItemResponse) ItemIds() []ewsxml.ItemId {
+	var res []ewsxml.ItemId
+	for _, msg := range r.ResponseMessages.UpdateItemResponseMessage {
+		res = append(res, msg.ItemIds()...)
+	}
+	return res
+}
+
+const OpUpdateItem Operation = "UpdateItem"
+
+func UpdateItem(ctx context.Context, req ews.Requester, op *UpdateItemOperation) (*UpdateItemResponse, error) {
+	ctx = setOperation(ctx, OpUpdateItem)
+
+	if op.UpdateItem.ConflictResolution == "" {
+		op.UpdateItem.ConflictResolution = ewsxml.ConflictResolution_AutoResolve
+	}
+
+	var out UpdateItemResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.UpdateItem), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteitem-operation
+type DeleteItemOperation struct {
+	Header     ewsxml.Header
+	DeleteItem ewsxml.DeleteItem
+}
+
+// DeleteItemResponse contains a ResponseMessage for each deleted item, in the
+// same order as the ids in the request.
+type DeleteItemResponse struct {
+	XMLName          xml.Name                 `xml:"DeleteItemResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>DeleteItemResponseMessage"`
+}
+
+func (r *DeleteItemResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpDeleteItem Operation = "DeleteItem"
+
+// DeleteItem deletes the items identified by op.DeleteItem. When one of the
+// items cannot be deleted an error is returned, the returned
+// DeleteItemResponse then still contains the results for all items.
+func DeleteItem(ctx context.Context, req ews.Requester, op *DeleteItemOperation) (*DeleteItemResponse, error) {
+	ctx = setOperation(ctx, OpDeleteItem)
+
+	if op.DeleteItem.DeleteType == "" {
+		op.DeleteItem.DeleteType = ewsxml.DeleteType_MoveToDeletedItems
+	}
+
+	var out DeleteItemResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.DeleteItem), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/moveitem-operation
+type MoveItemOperation struct {
+	Header   ewsxml.Header
+	MoveItem ewsxml.MoveItem
+}
+
+// MoveItemResponse contains a MoveItemResponseMessage for each moved item, in
+// the same order as the ids in the request. A failed move is reported in its
+// own response message and does not affect the other items.
+type MoveItemResponse struct {
+	XMLName          xml.Name `xml:"MoveItemResponse"`
+	ResponseMessages struct {
+		MoveItemResponseMessage []ewsxml.MoveItemResponseMessage
+	}
+}
+
+func (r *MoveItemResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.MoveItemResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpMoveItem Operation = "MoveItem"
+
+func MoveItem(ctx context.Context, req ews.Requester, op *MoveItemOperation) (*MoveItemResponse, error) {
+	var out MoveItemResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpMoveItem), &op.Header, op.MoveItem),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyitem-operation
+type CopyItemOperation struct {
+	Header   ewsxml.Header
+	CopyItem ewsxml.CopyItem
+}
+
+// CopyItemResponse contains a CopyItemResponseMessage for each copied item, in
+// the same order as the ids in the request.
+type CopyItemResponse struct {
+	XMLName          xml.Name `xml:"CopyItemResponse"`
+	ResponseMessages struct {
+		CopyItemResponseMessage []ewsxml.CopyItemResponseMessage
+	}
+}
+
+func (r *CopyItemResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.CopyItemResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+const OpCopyItem Operation = "CopyItem"
+
+func CopyItem(ctx context.Context, req ews.Requester, op *CopyItemOperation) (*CopyItemResponse, error) {
+	var out CopyItemResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpCopyItem), &op.Header, op.CopyItem),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/markasjunk-operation
+type MarkAsJunkOperation struct {
+	Header     ewsxml.Header
+	MarkAsJunk ewsxml.MarkAsJunk
+}
+
+// MarkAsJunkResponse contains a MarkAsJunkResponseMessage for each item, in
+// the same order as the ids in the request.
+type MarkAsJunkResponse struct {
+	XMLName          xml.Name `xml:"MarkAsJunkResponse"`
+	ResponseMessages struct {
+		MarkAsJunkResponseMessage []ewsxml.MarkAsJunkResponseMessage
+	}
+}
+
+func (r *MarkAsJunkResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.MarkAsJunkResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// MovedItemIds returns the new ItemId of each moved item.
+func (r *MarkAsJunkResponse) MovedItemIds() []ewsxml.ItemId {
+	var res []ewsxml.ItemId
+	for _, msg := range r.ResponseMessages.MarkAsJunkResponseMessage {
+		if msg.MovedItemId != nil {
+			res = append(res, *msg.MovedItemId)
+		}
+	}
+	return res
+}
+
+const OpMarkAsJunk Operation = "MarkAsJunk"
+
+func MarkAsJunk(ctx context.Context, req ews.Requester, op *MarkAsJunkOperation) (*MarkAsJunkResponse, error) {
+	var out MarkAsJunkResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpMarkAsJunk), &op.Header, op.MarkAsJunk),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/senditem-operation
+type SendItemOperation struct {
+	Header   ewsxml.Header
+	SendItem ewsxml.SendItem
+}
+
+// SendItemResponse contains a ResponseMessage for each sent item, in the same
+// order as the ids in the request.
+type SendItemResponse struct {
+	XMLName          xml.Name                 `xml:"SendItemResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>SendItemResponseMessage"`
+}
+
+func (r *SendItemResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpSendItem Operation = "SendItem"
+
+func SendItem(ctx context.Context, req ews.Requester, op *SendItemOperation) (*SendItemResponse, error) {
+	var out SendItemResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpSendItem), &op.Header, op.SendItem),
+		&out,
+	)
+}
@@ -0,0 +1,55 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getmailtips-operation
+type GetMailTipsOperation struct {
+	Header      ewsxml.Header
+	GetMailTips ewsxml.GetMailTips
+}
+
+// GetMailTipsResponse contains both the status of the request as a whole and
+// a response message with the MailTips of each of the recipients.
+type GetMailTipsResponse struct {
+	XMLName xml.Name `xml:"GetMailTipsResponse"`
+	ewsxml.ResponseMessage
+	ResponseMessages struct {
+		MailTipsResponseMessageType []ewsxml.MailTipsResponseMessage
+	}
+}
+
+func (r *GetMailTipsResponse) Response() *ewsxml.ResponseMessage {
+	if r.ResponseClass == ewsxml.ResponseClass_Error {
+		return &r.ResponseMessage
+	}
+
+	msgs := r.ResponseMessages.MailTipsResponseMessageType
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// MailTips returns the MailTips of each recipient.
+func (r *GetMailTipsResponse) MailTips() []ewsxml.MailTips {
+	res := make([]ewsxml.MailTips, 0, len(r.ResponseMessages.MailTipsResponseMessageType))
+	for _, msg := range r.ResponseMessages.MailTipsResponseMessageType {
+		res = append(res, msg.MailTips)
+	}
+	return res
+}
+
+const OpGetMailTips Operation = "GetMailTips"
+
+func GetMailTips(ctx context.Context, req ews.Requester, op *GetMailTipsOperation) (*GetMailTipsResponse, error) {
+	var out GetMailTipsResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpGetMailTips), &op.Header, op.GetMailTips),
+		&out,
+	)
+}
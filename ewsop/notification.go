@@ -0,0 +1,285 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/subscribe-operation
+type SubscribeOperation struct {
+	Header    ewsxml.Header
+	Subscribe ewsxml.Subscribe
+}
+
+type SubscribeResponse struct {
+	XMLName          xml.Name `xml:"SubscribeResponse"`
+	ResponseMessages struct {
+		SubscribeResponseMessage ewsxml.SubscribeResponseMessage
+	}
+}
+
+func (r *SubscribeResponse) Response() *ewsxml.ResponseMessage {
+	return r.ResponseMessages.SubscribeResponseMessage.Response()
+}
+
+const OpSubscribe Operation = "Subscribe"
+
+func Subscribe(ctx context.Context, req ews.Requester, op *SubscribeOperation) (*SubscribeResponse, error) {
+	var out SubscribeResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpSubscribe), &op.Header, op.Subscribe),
+		&out,
+	)
+}
+
+// DefaultPullSubscriptionTimeout is the timeout in minutes which is used when
+// none is provided to SubscribePull.
+const DefaultPullSubscriptionTimeout = 30
+
+// SubscribePull creates a pull subscription on the provided folders for the
+// provided event types. Use GetEvents with the returned subscription id and
+// watermark to poll for events.
+func SubscribePull(ctx context.Context, req ews.Requester, head *ewsxml.Header, folders ewsxml.FolderIds, events ...ewsxml.EventType) (*SubscribeResponse, error) {
+	op := SubscribeOperation{Subscribe: ewsxml.Subscribe{
+		PullSubscriptionRequest: &ewsxml.PullSubscriptionRequest{
+			FolderIds:  &folders,
+			EventTypes: events,
+			Timeout:    DefaultPullSubscriptionTimeout,
+		},
+	}}
+	if head != nil {
+		op.Header = *head
+	}
+	return Subscribe(ctx, req, &op)
+}
+
+// SubscribePush creates a push subscription on the provided folders for the
+// provided event types. The server posts notifications to url, which can be
+// parsed with ParseSendNotification.
+func SubscribePush(ctx context.Context, req ews.Requester, head *ewsxml.Header, url string, statusFrequency int, folders ewsxml.FolderIds, events ...ewsxml.EventType) (*SubscribeResponse, error) {
+	op := SubscribeOperation{Subscribe: ewsxml.Subscribe{
+		PushSubscriptionRequest: &ewsxml.PushSubscriptionRequest{
+			FolderIds:       &folders,
+			EventTypes:      events,
+			StatusFrequency: statusFrequency,
+			URL:             url,
+		},
+	}}
+	if head != nil {
+		op.Header = *head
+	}
+	return Subscribe(ctx, req, &op)
+}
+
+// ParseSendNotification parses the SOAP envelope of a push notification, as
+// posted by the server to the URL of a push subscription.
+func ParseSendNotification(r io.Reader) (*ewsxml.SendNotification, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var env ewsxml.ResponseEnvelope
+	if err = xml.Unmarshal(data, &env); err != nil {
+		return nil, errors.WithKind(err, ews.UnmarshalError)
+	}
+
+	var out ewsxml.SendNotification
+	if err = xml.Unmarshal(env.Body.Response, &out); err != nil {
+		return nil, errors.WithKind(err, ews.UnmarshalError)
+	}
+	return &out, nil
+}
+
+// WriteSendNotificationResult writes the SOAP envelope which should be sent
+// as response to a push notification. Status SubscriptionStatus_Unsubscribe
+// ends the subscription.
+func WriteSendNotificationResult(w io.Writer, status ewsxml.SubscriptionStatus) error {
+	x, err := xml.Marshal(ewsxml.SendNotificationResult{
+		SubscriptionStatus: status,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = io.WriteString(w, xml.Header+sendNotificationResultStart+string(x)+sendNotificationResultEnd)
+	return errors.WithStack(err)
+}
+
+//goland:noinspection HttpUrlsUsage
+const (
+	sendNotificationResultStart = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"><soap:Body>`
+	sendNotificationResultEnd   = `</soap:Body></soap:Envelope>`
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/unsubscribe-operation
+type UnsubscribeOperation struct {
+	Header      ewsxml.Header
+	Unsubscribe ewsxml.Unsubscribe
+}
+
+type UnsubscribeResponse struct {
+	XMLName          xml.Name                 `xml:"UnsubscribeResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>UnsubscribeResponseMessage"`
+}
+
+func (r *UnsubscribeResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return &r.ResponseMessages[i]
+	})
+}
+
+const OpUnsubscribe Operation = "Unsubscribe"
+
+// Unsubscribe ends a pull or streaming subscription and releases its
+// server-side resources. A push subscription is ended by responding with
+// SubscriptionStatus_Unsubscribe to its next notification instead.
+func Unsubscribe(ctx context.Context, req ews.Requester, op *UnsubscribeOperation) (*UnsubscribeResponse, error) {
+	var out UnsubscribeResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpUnsubscribe), &op.Header, op.Unsubscribe),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getevents-operation
+type GetEventsOperation struct {
+	Header    ewsxml.Header
+	GetEvents ewsxml.GetEvents
+}
+
+type GetEventsResponse struct {
+	XMLName          xml.Name `xml:"GetEventsResponse"`
+	ResponseMessages struct {
+		GetEventsResponseMessage ewsxml.GetEventsResponseMessage
+	}
+}
+
+func (r *GetEventsResponse) Response() *ewsxml.ResponseMessage {
+	return r.ResponseMessages.GetEventsResponseMessage.Response()
+}
+
+// Notification returns the received notification. Use its Watermark in the
+// next GetEvents request.
+func (r *GetEventsResponse) Notification() *ewsxml.Notification {
+	return &r.ResponseMessages.GetEventsResponseMessage.Notification
+}
+
+const OpGetEvents Operation = "GetEvents"
+
+func GetEvents(ctx context.Context, req ews.Requester, op *GetEventsOperation) (*GetEventsResponse, error) {
+	var out GetEventsResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpGetEvents), &op.Header, op.GetEvents),
+		&out,
+	)
+}
+
+// PollEvents requests all events of the pull subscription that occurred after
+// watermark. It keeps requesting events while the server indicates there are
+// more events available. The returned watermark should be used in the next
+// call to PollEvents, it is also returned when an error occurred after some
+// events have been received.
+func PollEvents(ctx context.Context, req ews.Requester, head *ewsxml.Header, subscriptionId, watermark string) ([]ewsxml.NotificationEvent, string, error) {
+	op := GetEventsOperation{GetEvents: ewsxml.GetEvents{
+		SubscriptionId: subscriptionId,
+		Watermark:      watermark,
+	}}
+	if head != nil {
+		op.Header = *head
+	}
+
+	var events []ewsxml.NotificationEvent
+	for {
+		resp, err := GetEvents(ctx, req, &op)
+		if err != nil {
+			return events, op.GetEvents.Watermark, err
+		}
+
+		n := resp.Notification()
+		events = append(events, n.Events...)
+		op.GetEvents.Watermark = n.Watermark()
+
+		if !n.MoreEvents {
+			return events, op.GetEvents.Watermark, nil
+		}
+	}
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getstreamingevents-operation
+type GetStreamingEventsOperation struct {
+	Header             ewsxml.Header
+	GetStreamingEvents ewsxml.GetStreamingEvents
+}
+
+const OpGetStreamingEvents Operation = "GetStreamingEvents"
+
+// DefaultStreamingConnectionTimeout is the connection timeout in minutes which
+// is used when none is provided to GetStreamingEvents.
+const DefaultStreamingConnectionTimeout = 30
+
+// GetStreamingEvents opens a long-lived connection and sends each received
+// notification to ch, until the server closes the connection after the
+// connection timeout or ctx is canceled. Notifications are decoded while they
+// arrive, the response is never read into memory as a whole. Call
+// GetStreamingEvents again to continue receiving events after it returns.
+func GetStreamingEvents(ctx context.Context, req ews.StreamRequester, op *GetStreamingEventsOperation, ch chan<- ewsxml.Notification) (err error) {
+	if op.GetStreamingEvents.ConnectionTimeout == 0 {
+		op.GetStreamingEvents.ConnectionTimeout = DefaultStreamingConnectionTimeout
+	}
+
+	body, err := req.Stream(ews.NewRequest(
+		setOperation(ctx, OpGetStreamingEvents),
+		&op.Header,
+		op.GetStreamingEvents,
+	))
+	if err != nil {
+		return err
+	}
+
+	defer errors.AppendFunc(&err, body.Close)
+	dec := xml.NewDecoder(body)
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return errors.WithStack(ctx.Err())
+			}
+			return errors.WithKind(err, ews.UnmarshalError)
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "GetStreamingEventsResponseMessage" {
+			continue
+		}
+
+		var msg ewsxml.GetStreamingEventsResponseMessage
+		if err = dec.DecodeElement(&msg, &start); err != nil {
+			return errors.WithKind(err, ews.UnmarshalError)
+		}
+		if msg.ResponseClass == ewsxml.ResponseClass_Error {
+			return errors.WithStack(&ews.ResponseError{Response: &msg})
+		}
+
+		for _, n := range msg.Notifications {
+			select {
+			case ch <- n:
+			case <-ctx.Done():
+				return errors.WithStack(ctx.Err())
+			}
+		}
+		if msg.ConnectionStatus == ewsxml.ConnectionStatus_Closed {
+			return nil
+		}
+	}
+}
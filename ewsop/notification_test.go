@@ -0,0 +1,153 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSendNotification(t *testing.T) {
+	const data = `<?xml version="1.0" encoding="utf-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <m:SendNotification xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+        xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:SendNotificationResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:Notification>
+            <t:SubscriptionId>FwBodXNkb2c</t:SubscriptionId>
+            <t:PreviousWatermark>AAAAAHYGAAAAAAAAAQ==</t:PreviousWatermark>
+            <t:MoreEvents>false</t:MoreEvents>
+            <t:NewMailEvent>
+              <t:Watermark>AAAAAHgGAAAAAAAAAQ==</t:Watermark>
+              <t:TimeStamp>2006-10-10T19:31:55Z</t:TimeStamp>
+              <t:ItemId Id="AAAtAEFkbWluaX" ChangeKey="CQAAAA==" />
+              <t:ParentFolderId Id="AAAtAEFkbWluaY" ChangeKey="AQAAAA==" />
+            </t:NewMailEvent>
+            <t:MovedEvent>
+              <t:Watermark>AAAAAHkGAAAAAAAAAQ==</t:Watermark>
+              <t:TimeStamp>2006-10-10T19:32:01Z</t:TimeStamp>
+              <t:ItemId Id="AAAtAEFkbWluaZ" ChangeKey="CQAAAB==" />
+              <t:ParentFolderId Id="AAAtAEFkbWluaW" ChangeKey="AQAAAA==" />
+              <t:OldItemId Id="AAAtAEFkbWluaX" ChangeKey="CQAAAA==" />
+              <t:OldParentFolderId Id="AAAtAEFkbWluaY" ChangeKey="AQAAAA==" />
+            </t:MovedEvent>
+          </m:Notification>
+        </m:SendNotificationResponseMessage>
+      </m:ResponseMessages>
+    </m:SendNotification>
+  </soap:Body>
+</soap:Envelope>`
+
+	sn, err := ParseSendNotification(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Len(t, sn.ResponseMessages.SendNotificationResponseMessage, 1)
+
+	n := sn.ResponseMessages.SendNotificationResponseMessage[0].Notification
+	assert.Equal(t, "FwBodXNkb2c", n.SubscriptionId)
+	assert.Len(t, n.Events, 2)
+	assert.Equal(t, ewsxml.EventType_NewMailEvent, n.Events[0].EventType)
+	assert.Equal(t, &ewsxml.ItemId{Id: "AAAtAEFkbWluaX", ChangeKey: "CQAAAA=="}, n.Events[0].ItemId)
+	assert.Equal(t, ewsxml.EventType_MovedEvent, n.Events[1].EventType)
+	assert.Equal(t, &ewsxml.ItemId{Id: "AAAtAEFkbWluaX", ChangeKey: "CQAAAA=="}, n.Events[1].OldItemId)
+	assert.Equal(t, "AAAAAHkGAAAAAAAAAQ==", n.Watermark())
+}
+
+func TestGetStreamingEvents(t *testing.T) {
+	const chunk = `<?xml version="1.0" encoding="utf-8"?>
+<Envelope xmlns="http://schemas.xmlsoap.org/soap/envelope/"><soap11:Header xmlns:soap11="http://schemas.xmlsoap.org/soap/envelope/" /><soap11:Body xmlns:soap11="http://schemas.xmlsoap.org/soap/envelope/">
+<m:GetStreamingEventsResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+<m:ResponseMessages><m:GetStreamingEventsResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+<m:ConnectionStatus>%s</m:ConnectionStatus>%s</m:GetStreamingEventsResponseMessage></m:ResponseMessages>
+</m:GetStreamingEventsResponse></soap11:Body></Envelope>`
+
+	const notification = `<m:Notifications><m:Notification><t:SubscriptionId>JwBkb2c</t:SubscriptionId>
+<t:CreatedEvent><t:TimeStamp>2009-09-23T13:01:56Z</t:TimeStamp><t:ItemId Id="AAAtAEFkbWluaX" ChangeKey="CQAAAA==" />
+<t:ParentFolderId Id="AAAtAEFkbWluaY" ChangeKey="AQAAAA==" /></t:CreatedEvent></m:Notification></m:Notifications>`
+
+	t.Run("events", func(t *testing.T) {
+		req := &streamRequester{body: fmt.Sprintf(chunk, "OK", notification) +
+			fmt.Sprintf(chunk, "OK", notification) +
+			fmt.Sprintf(chunk, "Closed", "")}
+
+		ch := make(chan ewsxml.Notification, 10)
+		op := GetStreamingEventsOperation{GetStreamingEvents: ewsxml.GetStreamingEvents{
+			SubscriptionIds: []string{"JwBkb2c"},
+		}}
+
+		assert.NoError(t, GetStreamingEvents(context.Background(), req, &op, ch))
+		assert.Equal(t, DefaultStreamingConnectionTimeout, op.GetStreamingEvents.ConnectionTimeout)
+		assert.Len(t, ch, 2)
+
+		n := <-ch
+		assert.Equal(t, "JwBkb2c", n.SubscriptionId)
+		assert.Equal(t, ewsxml.EventType_CreatedEvent, n.Events[0].EventType)
+	})
+	t.Run("canceled", func(t *testing.T) {
+		req := &streamRequester{body: fmt.Sprintf(chunk, "OK", notification)}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := GetStreamingEvents(ctx, req, new(GetStreamingEventsOperation), make(chan ewsxml.Notification))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+	t.Run("longer than client timeout", func(t *testing.T) {
+		const timeout = 50 * time.Millisecond
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, chunk, "OK", notification)
+			w.(http.Flusher).Flush()
+			time.Sleep(timeout * 4)
+			_, _ = fmt.Fprintf(w, chunk, "Closed", notification)
+		}))
+		defer srv.Close()
+
+		c, err := ews.NewClient(srv.URL, ews.Exchange2013, ews.WithTimeout(timeout))
+		assert.NoError(t, err)
+
+		ch := make(chan ewsxml.Notification, 10)
+		assert.NoError(t, GetStreamingEvents(context.Background(), c, new(GetStreamingEventsOperation), ch))
+		assert.Len(t, ch, 2)
+	})
+}
+
+type responsesRequester struct {
+	responses []string
+}
+
+func (rr *responsesRequester) Request(_ *ews.Request, out interface{}) error {
+	data := rr.responses[0]
+	rr.responses = rr.responses[1:]
+	return xml.Unmarshal([]byte(data), out)
+}
+
+func TestPollEvents(t *testing.T) {
+	const resp = `<m:GetEventsResponse><m:ResponseMessages><m:GetEventsResponseMessage ResponseClass="Success">
+<m:ResponseCode>NoError</m:ResponseCode><m:Notification><t:SubscriptionId>FwBodXNkb2c</t:SubscriptionId>
+<t:PreviousWatermark>%s</t:PreviousWatermark><t:MoreEvents>%t</t:MoreEvents>
+<t:ModifiedEvent><t:Watermark>%s</t:Watermark><t:TimeStamp>2006-10-10T19:31:55Z</t:TimeStamp>
+<t:FolderId Id="AAAtAEFkbWluaY" ChangeKey="AQAAAA==" /><t:UnreadCount>%d</t:UnreadCount></t:ModifiedEvent>
+</m:Notification></m:GetEventsResponseMessage></m:ResponseMessages></m:GetEventsResponse>`
+
+	req := &responsesRequester{responses: []string{
+		fmt.Sprintf(resp, "wm0", true, "wm1", 1),
+		fmt.Sprintf(resp, "wm1", false, "wm2", 2),
+	}}
+
+	events, wm, err := PollEvents(context.Background(), req, nil, "FwBodXNkb2c", "wm0")
+	assert.NoError(t, err)
+	assert.Equal(t, "wm2", wm)
+	assert.Len(t, events, 2)
+	assert.Equal(t, ewsxml.EventType_ModifiedEvent, events[1].EventType)
+	assert.Equal(t, 2, events[1].UnreadCount)
+	assert.Empty(t, req.responses)
+}
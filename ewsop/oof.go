@@ -0,0 +1,66 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuseroofsettings-operation
+type GetUserOofSettingsOperation struct {
+	Header                    ewsxml.Header
+	GetUserOofSettingsRequest ewsxml.GetUserOofSettingsRequest
+}
+
+// GetUserOofSettingsResponse contains a single ResponseMessage element
+// instead of the ResponseMessages element which is used by most operations.
+type GetUserOofSettingsResponse struct {
+	XMLName          xml.Name `xml:"GetUserOofSettingsResponse"`
+	ResponseMessage  ewsxml.ResponseMessage
+	OofSettings      ewsxml.OofSettings
+	AllowExternalOof ewsxml.ExternalAudience
+}
+
+func (r *GetUserOofSettingsResponse) Response() *ewsxml.ResponseMessage {
+	return &r.ResponseMessage
+}
+
+const OpGetUserOofSettings Operation = "GetUserOofSettings"
+
+func GetUserOofSettings(ctx context.Context, req ews.Requester, op *GetUserOofSettingsOperation) (*GetUserOofSettingsResponse, error) {
+	var out GetUserOofSettingsResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpGetUserOofSettings), &op.Header, op.GetUserOofSettingsRequest),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/setuseroofsettings-operation
+type SetUserOofSettingsOperation struct {
+	Header                    ewsxml.Header
+	SetUserOofSettingsRequest ewsxml.SetUserOofSettingsRequest
+}
+
+type SetUserOofSettingsResponse struct {
+	XMLName         xml.Name `xml:"SetUserOofSettingsResponse"`
+	ResponseMessage ewsxml.ResponseMessage
+}
+
+func (r *SetUserOofSettingsResponse) Response() *ewsxml.ResponseMessage {
+	return &r.ResponseMessage
+}
+
+const OpSetUserOofSettings Operation = "SetUserOofSettings"
+
+func SetUserOofSettings(ctx context.Context, req ews.Requester, op *SetUserOofSettingsOperation) (*SetUserOofSettingsResponse, error) {
+	ctx = setOperation(ctx, OpSetUserOofSettings)
+
+	if op.SetUserOofSettingsRequest.UserOofSettings.ExternalAudience == "" {
+		op.SetUserOofSettingsRequest.UserOofSettings.ExternalAudience = ewsxml.ExternalAudience_None
+	}
+
+	var out SetUserOofSettingsResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.SetUserOofSettingsRequest), &out)
+}
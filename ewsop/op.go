@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
 )
 
 type Operation string
@@ -30,3 +31,17 @@ func GetOperation(ctx context.Context) (OperationContext, bool) {
 	}
 	return v, ok
 }
+
+// firstResponse returns the first of n ResponseMessages with an error, or the
+// first ResponseMessage when none of them contain an error.
+func firstResponse(n int, get func(i int) *ewsxml.ResponseMessage) *ewsxml.ResponseMessage {
+	if n == 0 {
+		return new(ewsxml.ResponseMessage)
+	}
+	for i := 0; i < n; i++ {
+		if r := get(i); r.ResponseClass == ewsxml.ResponseClass_Error {
+			return r
+		}
+	}
+	return get(0)
+}
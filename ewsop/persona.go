@@ -0,0 +1,33 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getpersona-operation
+type GetPersonaOperation struct {
+	Header     ewsxml.Header
+	GetPersona ewsxml.GetPersona
+}
+
+type GetPersonaResponse struct {
+	XMLName xml.Name `xml:"GetPersonaResponseMessage"`
+	ewsxml.GetPersonaResponseMessage
+}
+
+const OpGetPersona Operation = "GetPersona"
+
+// GetPersona retrieves the full Persona identified by the PersonaId of op,
+// including all of its attributed values, like phone numbers and office
+// locations, which are not returned by FindPeople.
+func GetPersona(ctx context.Context, req ews.Requester, op *GetPersonaOperation) (*GetPersonaResponse, error) {
+	var out GetPersonaResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpGetPersona), &op.Header, op.GetPersona),
+		&out,
+	)
+}
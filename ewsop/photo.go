@@ -0,0 +1,82 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuserphoto-operation
+type GetUserPhotoOperation struct {
+	Header       ewsxml.Header
+	GetUserPhoto ewsxml.GetUserPhoto
+}
+
+// GetUserPhotoResponse contains the base64 encoded PictureData of the
+// requested photo.
+type GetUserPhotoResponse struct {
+	XMLName xml.Name `xml:"GetUserPhotoResponse"`
+	ewsxml.ResponseMessage
+	HasChanged  bool
+	PictureData string
+}
+
+// Bytes returns the decoded PictureData.
+func (r *GetUserPhotoResponse) Bytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(r.PictureData)
+}
+
+const (
+	OpGetUserPhoto Operation = "GetUserPhoto"
+
+	ErrNoUserPhoto errors.Msg = "user does not have a photo"
+)
+
+// GetUserPhoto gets the photo of the user identified by
+// op.GetUserPhoto.Email. It returns an ErrNoUserPhoto error when the user
+// does not have a photo.
+func GetUserPhoto(ctx context.Context, req ews.Requester, op *GetUserPhotoOperation) (*GetUserPhotoResponse, error) {
+	ctx = setOperation(ctx, OpGetUserPhoto)
+
+	if op.GetUserPhoto.SizeRequested == "" {
+		op.GetUserPhoto.SizeRequested = ewsxml.UserPhotoSize_HR96x96
+	}
+
+	var out GetUserPhotoResponse
+	err := req.Request(ews.NewRequest(ctx, &op.Header, op.GetUserPhoto), &out)
+
+	var httpErr *ews.HTTPError
+	if errors.Is(err, ewsxml.ErrorItemNotFound) ||
+		(errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound) ||
+		(err == nil && out.PictureData == "") {
+		return &out, errors.New(ErrNoUserPhoto)
+	}
+	return &out, err
+}
+
+// SetUserPhotoOperation sets the photo of a user. It is supported by
+// Exchange Online and Exchange 2016 and later.
+type SetUserPhotoOperation struct {
+	Header       ewsxml.Header
+	SetUserPhoto ewsxml.SetUserPhoto
+}
+
+type SetUserPhotoResponse struct {
+	XMLName xml.Name `xml:"SetUserPhotoResponse"`
+	ewsxml.ResponseMessage
+}
+
+const OpSetUserPhoto Operation = "SetUserPhoto"
+
+func SetUserPhoto(ctx context.Context, req ews.Requester, op *SetUserPhotoOperation) (*SetUserPhotoResponse, error) {
+	var out SetUserPhotoResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpSetUserPhoto), &op.Header, op.SetUserPhoto),
+		&out,
+	)
+}
@@ -0,0 +1,42 @@
+package ewsop
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type errRequester struct{ err error }
+
+func (er *errRequester) Request(*ews.Request, interface{}) error { return er.err }
+
+func TestGetUserPhoto(t *testing.T) {
+	t.Run("photo", func(t *testing.T) {
+		req := &responsesRequester{responses: []string{`<GetUserPhotoResponse ResponseClass="Success">
+<ResponseCode>NoError</ResponseCode><HasChanged>true</HasChanged><PictureData>iVBORw==</PictureData>
+</GetUserPhotoResponse>`}}
+
+		resp, err := GetUserPhoto(context.Background(), req, new(GetUserPhotoOperation))
+		assert.NoError(t, err)
+
+		data, err := resp.Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x89, 'P', 'N', 'G'}, data)
+	})
+
+	tests := map[string]ews.Requester{
+		"empty": &responsesRequester{responses: []string{`<GetUserPhotoResponse ResponseClass="Success">
+<ResponseCode>NoError</ResponseCode><HasChanged>false</HasChanged></GetUserPhotoResponse>`}},
+		"not found": &errRequester{err: &ews.HTTPError{Status: "404 Not Found", StatusCode: http.StatusNotFound}},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := GetUserPhoto(context.Background(), req, new(GetUserPhotoOperation))
+			assert.True(t, errors.Is(err, ErrNoUserPhoto))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/resolvenames-operation
+type ResolveNamesOperation struct {
+	Header       ewsxml.Header
+	ResolveNames ewsxml.ResolveNames
+}
+
+type ResolveNamesResponse struct {
+	XMLName          xml.Name `xml:"ResolveNamesResponse"`
+	ResponseMessages struct {
+		ResolveNamesResponseMessage ewsxml.ResolveNamesResponseMessage
+	}
+}
+
+func (r *ResolveNamesResponse) Response() *ewsxml.ResponseMessage {
+	return r.ResponseMessages.ResolveNamesResponseMessage.Response()
+}
+
+// Resolutions returns all resolved candidates.
+func (r *ResolveNamesResponse) Resolutions() []ewsxml.Resolution {
+	return r.ResponseMessages.ResolveNamesResponseMessage.ResolutionSet.Resolution
+}
+
+const OpResolveNames Operation = "ResolveNames"
+
+func ResolveNames(ctx context.Context, req ews.Requester, op *ResolveNamesOperation) (*ResolveNamesResponse, error) {
+	var out ResolveNamesResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpResolveNames), &op.Header, op.ResolveNames),
+		&out,
+	)
+}
@@ -2,24 +2,21 @@ package ewsop
 
 import (
 	"context"
-	"encoding/xml"
 
 	"github.com/Abovo-Media/go-ews"
 	"github.com/Abovo-Media/go-ews/ewsxml"
 )
 
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getroomlists-operation
 type GetRoomListsOperation struct {
-	Header ewsxml.Header
-	body   struct {
-		XMLName xml.Name `xml:"m:GetRoomLists"`
-	}
+	Header       ewsxml.Header
+	GetRoomLists ewsxml.GetRoomLists
 }
 
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getroomlistsresponse
 type GetRoomListsResponse struct {
 	ewsxml.ResponseMessage
-	RoomLists struct {
-		Address []ewsxml.Mailbox `xml:"Address"`
-	} `xml:"RoomLists"`
+	RoomLists []ewsxml.Mailbox `xml:"RoomLists>Address"`
 }
 
 const OpGetRoomLists Operation = "GetRoomLists"
@@ -27,7 +24,7 @@ const OpGetRoomLists Operation = "GetRoomLists"
 func GetRoomLists(ctx context.Context, req ews.Requester, op *GetRoomListsOperation) (*GetRoomListsResponse, error) {
 	var out GetRoomListsResponse
 	return &out, req.Request(
-		ews.NewRequest(setOperation(ctx, OpGetRoomLists), &op.Header, op.body),
+		ews.NewRequest(setOperation(ctx, OpGetRoomLists), &op.Header, op.GetRoomLists),
 		&out,
 	)
 }
@@ -16,16 +16,21 @@ type GetRoomsOperation struct {
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getroomsresponse
 type GetRoomsResponse struct {
 	ewsxml.ResponseMessage
-	Rooms []struct {
-		Name         string
-		EmailAddress string
-		RoutingType  ewsxml.RoutingType
-		MailboxType  ewsxml.MailboxType
-	} `xml:"Rooms>Room>Id"`
+	Rooms []ewsxml.Room `xml:"Rooms>Room"`
+}
+
+// Mailboxes returns the Mailbox of each room.
+func (r *GetRoomsResponse) Mailboxes() []ewsxml.Mailbox {
+	res := make([]ewsxml.Mailbox, len(r.Rooms))
+	for i, room := range r.Rooms {
+		res[i] = room.Id
+	}
+	return res
 }
 
 const OpGetRooms Operation = "GetRooms"
 
+// GetRooms requests the rooms within a room list, as returned by GetRoomLists.
 func GetRooms(ctx context.Context, req ews.Requester, op *GetRoomsOperation) (*GetRoomsResponse, error) {
 	var out GetRoomsResponse
 	return &out, req.Request(
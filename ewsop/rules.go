@@ -0,0 +1,56 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getinboxrules-operation
+type GetInboxRulesOperation struct {
+	Header        ewsxml.Header
+	GetInboxRules ewsxml.GetInboxRules
+}
+
+type GetInboxRulesResponse struct {
+	XMLName xml.Name `xml:"GetInboxRulesResponse"`
+	ewsxml.ResponseMessage
+	OutlookRuleBlobExists bool
+	InboxRules            []ewsxml.Rule `xml:"InboxRules>Rule"`
+}
+
+const OpGetInboxRules Operation = "GetInboxRules"
+
+func GetInboxRules(ctx context.Context, req ews.Requester, op *GetInboxRulesOperation) (*GetInboxRulesResponse, error) {
+	var out GetInboxRulesResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpGetInboxRules), &op.Header, op.GetInboxRules),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateinboxrules-operation
+type UpdateInboxRulesOperation struct {
+	Header           ewsxml.Header
+	UpdateInboxRules ewsxml.UpdateInboxRules
+}
+
+// UpdateInboxRulesResponse contains the RuleOperationErrors of the operations
+// which failed, in which case ResponseCode is ErrorInboxRulesValidationError.
+type UpdateInboxRulesResponse struct {
+	XMLName xml.Name `xml:"UpdateInboxRulesResponse"`
+	ewsxml.ResponseMessage
+	RuleOperationErrors []ewsxml.RuleOperationError `xml:"RuleOperationErrors>RuleOperationError"`
+}
+
+const OpUpdateInboxRules Operation = "UpdateInboxRules"
+
+func UpdateInboxRules(ctx context.Context, req ews.Requester, op *UpdateInboxRulesOperation) (*UpdateInboxRulesResponse, error) {
+	var out UpdateInboxRulesResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpUpdateInboxRules), &op.Header, op.UpdateInboxRules),
+		&out,
+	)
+}
@@ -2,6 +2,7 @@ package ewsop
 
 import (
 	"context"
+	"encoding/xml"
 
 	ews "github.com/Abovo-Media/go-ews"
 	"github.com/Abovo-Media/go-ews/ewsxml"
@@ -14,11 +15,9 @@ type GetServerTimeZonesOperation struct {
 }
 
 type GetServerTimeZonesResponse struct {
+	XMLName          xml.Name `xml:"GetServerTimeZonesResponse"`
 	ResponseMessages struct {
-		GetServerTimeZonesResponseMessage struct {
-			ewsxml.ResponseMessage
-			TimeZoneDefinitions []ewsxml.TimeZoneDefinition
-		}
+		GetServerTimeZonesResponseMessage ewsxml.GetServerTimeZonesResponseMessage
 	}
 }
 
@@ -26,6 +25,11 @@ func (r *GetServerTimeZonesResponse) Response() *ewsxml.ResponseMessage {
 	return r.ResponseMessages.GetServerTimeZonesResponseMessage.Response()
 }
 
+// TimeZoneDefinitions returns the time zone definitions in the response.
+func (r *GetServerTimeZonesResponse) TimeZoneDefinitions() []ewsxml.TimeZoneDefinition {
+	return r.ResponseMessages.GetServerTimeZonesResponseMessage.TimeZoneDefinitions
+}
+
 const OpGetServerTimeZones Operation = "GetServerTimeZones"
 
 func GetServerTimeZones(ctx context.Context, req ews.Requester, op *GetServerTimeZonesOperation) (*GetServerTimeZonesResponse, error) {
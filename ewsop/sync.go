@@ -0,0 +1,76 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/syncfolderitems-operation
+type SyncFolderItemsOperation struct {
+	Header          ewsxml.Header
+	SyncFolderItems ewsxml.SyncFolderItems
+}
+
+type SyncFolderItemsResponse struct {
+	XMLName          xml.Name `xml:"SyncFolderItemsResponse"`
+	ResponseMessages struct {
+		SyncFolderItemsResponseMessage ewsxml.SyncFolderItemsResponseMessage
+	}
+}
+
+func (r *SyncFolderItemsResponse) Response() *ewsxml.ResponseMessage {
+	return r.ResponseMessages.SyncFolderItemsResponseMessage.Response()
+}
+
+const OpSyncFolderItems Operation = "SyncFolderItems"
+
+// DefaultMaxChangesReturned is used when SyncFolderItems.MaxChangesReturned is
+// not set.
+const DefaultMaxChangesReturned = 100
+
+func SyncFolderItems(ctx context.Context, req ews.Requester, op *SyncFolderItemsOperation) (*SyncFolderItemsResponse, error) {
+	ctx = setOperation(ctx, OpSyncFolderItems)
+
+	if op.SyncFolderItems.ItemShape.BaseShape == "" {
+		op.SyncFolderItems.ItemShape.BaseShape = ewsxml.BaseShape_IdOnly
+	}
+	if op.SyncFolderItems.MaxChangesReturned == 0 {
+		op.SyncFolderItems.MaxChangesReturned = DefaultMaxChangesReturned
+	}
+
+	var out SyncFolderItemsResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.SyncFolderItems), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/syncfolderhierarchy-operation
+type SyncFolderHierarchyOperation struct {
+	Header              ewsxml.Header
+	SyncFolderHierarchy ewsxml.SyncFolderHierarchy
+}
+
+type SyncFolderHierarchyResponse struct {
+	XMLName          xml.Name `xml:"SyncFolderHierarchyResponse"`
+	ResponseMessages struct {
+		SyncFolderHierarchyResponseMessage ewsxml.SyncFolderHierarchyResponseMessage
+	}
+}
+
+func (r *SyncFolderHierarchyResponse) Response() *ewsxml.ResponseMessage {
+	return r.ResponseMessages.SyncFolderHierarchyResponseMessage.Response()
+}
+
+const OpSyncFolderHierarchy Operation = "SyncFolderHierarchy"
+
+func SyncFolderHierarchy(ctx context.Context, req ews.Requester, op *SyncFolderHierarchyOperation) (*SyncFolderHierarchyResponse, error) {
+	ctx = setOperation(ctx, OpSyncFolderHierarchy)
+
+	if op.SyncFolderHierarchy.FolderShape.BaseShape == "" {
+		op.SyncFolderHierarchy.FolderShape.BaseShape = ewsxml.BaseShape_IdOnly
+	}
+
+	var out SyncFolderHierarchyResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.SyncFolderHierarchy), &out)
+}
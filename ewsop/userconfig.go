@@ -0,0 +1,136 @@
+package ewsop
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/Abovo-Media/go-ews"
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuserconfiguration-operation
+type GetUserConfigurationOperation struct {
+	Header               ewsxml.Header
+	GetUserConfiguration ewsxml.GetUserConfiguration
+}
+
+type GetUserConfigurationResponse struct {
+	XMLName          xml.Name `xml:"GetUserConfigurationResponse"`
+	ResponseMessages struct {
+		GetUserConfigurationResponseMessage []ewsxml.GetUserConfigurationResponseMessage
+	}
+}
+
+func (r *GetUserConfigurationResponse) Response() *ewsxml.ResponseMessage {
+	msgs := r.ResponseMessages.GetUserConfigurationResponseMessage
+	return firstResponse(len(msgs), func(i int) *ewsxml.ResponseMessage {
+		return msgs[i].Response()
+	})
+}
+
+// UserConfiguration returns the requested user configuration object.
+func (r *GetUserConfigurationResponse) UserConfiguration() *ewsxml.UserConfiguration {
+	if msgs := r.ResponseMessages.GetUserConfigurationResponseMessage; len(msgs) != 0 {
+		return &msgs[0].UserConfiguration
+	}
+	return nil
+}
+
+const OpGetUserConfiguration Operation = "GetUserConfiguration"
+
+// GetUserConfiguration gets the user configuration object identified by
+// op.GetUserConfiguration.UserConfigurationName. All of its parts are
+// returned when UserConfigurationProperties is empty.
+func GetUserConfiguration(ctx context.Context, req ews.Requester, op *GetUserConfigurationOperation) (*GetUserConfigurationResponse, error) {
+	ctx = setOperation(ctx, OpGetUserConfiguration)
+
+	if len(op.GetUserConfiguration.UserConfigurationProperties) == 0 {
+		op.GetUserConfiguration.UserConfigurationProperties = ewsxml.UserConfigurationProperties{
+			ewsxml.UserConfigurationProperty_All,
+		}
+	}
+
+	var out GetUserConfigurationResponse
+	return &out, req.Request(ews.NewRequest(ctx, &op.Header, op.GetUserConfiguration), &out)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createuserconfiguration-operation
+type CreateUserConfigurationOperation struct {
+	Header                  ewsxml.Header
+	CreateUserConfiguration ewsxml.CreateUserConfiguration
+}
+
+type CreateUserConfigurationResponse struct {
+	XMLName          xml.Name                 `xml:"CreateUserConfigurationResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>CreateUserConfigurationResponseMessage"`
+}
+
+func (r *CreateUserConfigurationResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpCreateUserConfiguration Operation = "CreateUserConfiguration"
+
+func CreateUserConfiguration(ctx context.Context, req ews.Requester, op *CreateUserConfigurationOperation) (*CreateUserConfigurationResponse, error) {
+	var out CreateUserConfigurationResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpCreateUserConfiguration), &op.Header, op.CreateUserConfiguration),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateuserconfiguration-operation
+type UpdateUserConfigurationOperation struct {
+	Header                  ewsxml.Header
+	UpdateUserConfiguration ewsxml.UpdateUserConfiguration
+}
+
+type UpdateUserConfigurationResponse struct {
+	XMLName          xml.Name                 `xml:"UpdateUserConfigurationResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>UpdateUserConfigurationResponseMessage"`
+}
+
+func (r *UpdateUserConfigurationResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpUpdateUserConfiguration Operation = "UpdateUserConfiguration"
+
+func UpdateUserConfiguration(ctx context.Context, req ews.Requester, op *UpdateUserConfigurationOperation) (*UpdateUserConfigurationResponse, error) {
+	var out UpdateUserConfigurationResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpUpdateUserConfiguration), &op.Header, op.UpdateUserConfiguration),
+		&out,
+	)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteuserconfiguration-operation
+type DeleteUserConfigurationOperation struct {
+	Header                  ewsxml.Header
+	DeleteUserConfiguration ewsxml.DeleteUserConfiguration
+}
+
+type DeleteUserConfigurationResponse struct {
+	XMLName          xml.Name                 `xml:"DeleteUserConfigurationResponse"`
+	ResponseMessages []ewsxml.ResponseMessage `xml:"ResponseMessages>DeleteUserConfigurationResponseMessage"`
+}
+
+func (r *DeleteUserConfigurationResponse) Response() *ewsxml.ResponseMessage {
+	return firstResponse(len(r.ResponseMessages), func(i int) *ewsxml.ResponseMessage {
+		return r.ResponseMessages[i].Response()
+	})
+}
+
+const OpDeleteUserConfiguration Operation = "DeleteUserConfiguration"
+
+func DeleteUserConfiguration(ctx context.Context, req ews.Requester, op *DeleteUserConfigurationOperation) (*DeleteUserConfigurationResponse, error) {
+	var out DeleteUserConfigurationResponse
+	return &out, req.Request(
+		ews.NewRequest(setOperation(ctx, OpDeleteUserConfiguration), &op.Header, op.DeleteUserConfiguration),
+		&out,
+	)
+}
@@ -0,0 +1,177 @@
+package ewsxml
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+)
+
+// The AttachmentId element identifies an item or file attachment.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/attachmentid
+type AttachmentId struct {
+	Id                string `xml:",attr"`
+	RootItemId        string `xml:",attr,omitempty"`
+	RootItemChangeKey string `xml:",attr,omitempty"`
+}
+
+// The Attachments element contains the items or files that are attached to an
+// item in the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/attachments-ex15websvcsotherref
+type Attachments struct {
+	FileAttachment []FileAttachment `xml:",omitempty"`
+	ItemAttachment []ItemAttachment `xml:",omitempty"`
+}
+
+// AttachmentIds returns the AttachmentId of each attachment which has one.
+func (a *Attachments) AttachmentIds() []AttachmentId {
+	res := make([]AttachmentId, 0, len(a.FileAttachment)+len(a.ItemAttachment))
+	for _, fa := range a.FileAttachment {
+		if fa.AttachmentId != nil {
+			res = append(res, *fa.AttachmentId)
+		}
+	}
+	for _, ia := range a.ItemAttachment {
+		if ia.AttachmentId != nil {
+			res = append(res, *ia.AttachmentId)
+		}
+	}
+	return res
+}
+
+// The FileAttachment element represents a file that is attached to an item in
+// the Exchange store. Content contains the base64 encoded contents of the
+// file.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/fileattachment
+type FileAttachment struct {
+	AttachmentId *AttachmentId `xml:",omitempty"`
+	Name         string        `xml:",omitempty"`
+	ContentType  string        `xml:",omitempty"`
+	ContentId    string        `xml:",omitempty"`
+	Size         int           `xml:",omitempty"`
+	IsInline     bool          `xml:",omitempty"`
+	Content      string        `xml:",omitempty"`
+}
+
+// NewFileAttachment creates a FileAttachment with the base64 encoded data as
+// its Content.
+func NewFileAttachment(name, contentType string, data []byte) FileAttachment {
+	return FileAttachment{
+		Name:        name,
+		ContentType: contentType,
+		Content:     base64.StdEncoding.EncodeToString(data),
+	}
+}
+
+// Bytes returns the decoded contents of the file. It returns nil when Content
+// is empty, e.g. when the attachment is returned from a GetItem request.
+func (fa FileAttachment) Bytes() ([]byte, error) {
+	if fa.Content == "" {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(fa.Content)
+}
+
+// NewInlineFileAttachment creates a FileAttachment which is displayed inline,
+// e.g. an embedded image which is referenced by contentId from an html body
+// using "cid:<contentId>".
+func NewInlineFileAttachment(name, contentType, contentId string, data []byte) FileAttachment {
+	fa := NewFileAttachment(name, contentType, data)
+	fa.ContentId = contentId
+	fa.IsInline = true
+	return fa
+}
+
+// The ItemAttachment element represents an Exchange item that is attached to
+// another Exchange item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/itemattachment
+type ItemAttachment struct {
+	AttachmentId        *AttachmentId        `xml:",omitempty"`
+	Name                string               `xml:",omitempty"`
+	ContentType         string               `xml:",omitempty"`
+	ContentId           string               `xml:",omitempty"`
+	Size                int                  `xml:",omitempty"`
+	IsInline            bool                 `xml:",omitempty"`
+	Message             *Message             `xml:",omitempty"`
+	CalendarItem        *CalendarItem        `xml:",omitempty"`
+	Contact             *Contact             `xml:",omitempty"`
+	MeetingMessage      *MeetingMessage      `xml:",omitempty"`
+	MeetingRequest      *MeetingRequest      `xml:",omitempty"`
+	MeetingResponse     *MeetingResponse     `xml:",omitempty"`
+	MeetingCancellation *MeetingCancellation `xml:",omitempty"`
+	Task                *Task                `xml:",omitempty"`
+	PostItem            *PostItem            `xml:",omitempty"`
+}
+
+// The AttachmentShape element identifies additional extended item properties
+// to return in a GetAttachment operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/attachmentshape
+type AttachmentShape struct {
+	XMLName              xml.Name              `xml:"m:AttachmentShape"`
+	IncludeMimeContent   bool                  `xml:",omitempty"`
+	BodyType             BodyType              `xml:",omitempty"`
+	FilterHtmlContent    bool                  `xml:",omitempty"`
+	AdditionalProperties *AdditionalProperties `xml:",omitempty"`
+}
+
+// The GetAttachment element is used in a request to get attachments from an
+// item in the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getattachment
+type GetAttachment struct {
+	XMLName         xml.Name         `xml:"m:GetAttachment"`
+	AttachmentShape *AttachmentShape `xml:",omitempty"`
+	AttachmentIds   []AttachmentId   `xml:"m:AttachmentIds>AttachmentId"`
+}
+
+// The GetAttachmentResponseMessage element contains the status and result of
+// a single GetAttachment request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getattachmentresponsemessage
+type GetAttachmentResponseMessage struct {
+	ResponseMessage
+	Attachments Attachments
+}
+
+// The CreateAttachment element defines a request to create an attachment to an
+// item in the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createattachment
+type CreateAttachment struct {
+	XMLName      xml.Name    `xml:"m:CreateAttachment"`
+	ParentItemId ItemId      `xml:"m:ParentItemId"`
+	Attachments  Attachments `xml:"m:Attachments"`
+}
+
+// The CreateAttachmentResponseMessage element contains the status and result
+// of a single CreateAttachment request. The AttachmentId of each created
+// attachment contains the RootItemChangeKey, which is the new ChangeKey of
+// the parent item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createattachmentresponsemessage
+type CreateAttachmentResponseMessage struct {
+	ResponseMessage
+	Attachments Attachments
+}
+
+// The DeleteAttachment element defines a request to delete attachments from
+// items in the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteattachment
+type DeleteAttachment struct {
+	XMLName       xml.Name       `xml:"m:DeleteAttachment"`
+	AttachmentIds []AttachmentId `xml:"m:AttachmentIds>AttachmentId"`
+}
+
+// The DeleteAttachmentResponseMessage element contains the status and result
+// of a single DeleteAttachment request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteattachmentresponsemessage
+type DeleteAttachmentResponseMessage struct {
+	ResponseMessage
+	RootItemId *RootItemId `xml:",omitempty"`
+}
+
+// The RootItemId element identifies the root item of a deleted attachment.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/rootitemid
+type RootItemId struct {
+	RootItemId        string `xml:",attr"`
+	RootItemChangeKey string `xml:",attr"`
+}
+
+// ItemId returns the RootItemId as ItemId.
+func (r RootItemId) ItemId() ItemId {
+	return ItemId{Id: r.RootItemId, ChangeKey: r.RootItemChangeKey}
+}
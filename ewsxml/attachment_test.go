@@ -0,0 +1,50 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessage_Attachments(t *testing.T) {
+	const data = `<t:Message xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:ItemId Id="AAAlAF" ChangeKey="CQAAAB" />
+  <t:Attachments>
+    <t:FileAttachment>
+      <t:AttachmentId Id="AAAlAFV" />
+      <t:Name>hello.txt</t:Name>
+      <t:ContentType>text/plain</t:ContentType>
+      <t:Content>aGVsbG8g
+d29ybGQ=</t:Content>
+    </t:FileAttachment>
+    <t:ItemAttachment>
+      <t:AttachmentId Id="AAAlAFW" />
+      <t:Name>Forwarded</t:Name>
+      <t:Message><t:Subject>Original</t:Subject></t:Message>
+    </t:ItemAttachment>
+  </t:Attachments>
+  <t:HasAttachments>true</t:HasAttachments>
+</t:Message>`
+
+	var msg Message
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.True(t, msg.HasAttachments)
+	assert.Equal(t, []AttachmentId{{Id: "AAAlAFV"}, {Id: "AAAlAFW"}}, msg.Attachments.AttachmentIds())
+	assert.Equal(t, "Original", msg.Attachments.ItemAttachment[0].Message.Subject)
+
+	content, err := msg.Attachments.FileAttachment[0].Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+}
+
+func TestFileAttachment_Bytes(t *testing.T) {
+	fa := NewFileAttachment("data.bin", "application/octet-stream", []byte{0, 1, 2, 0xff})
+	have, err := fa.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 2, 0xff}, have)
+
+	have, err = FileAttachment{}.Bytes()
+	assert.NoError(t, err)
+	assert.Nil(t, have)
+}
@@ -0,0 +1,298 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type AttendeeType string
+
+func (s AttendeeType) String() string { return string(s) }
+
+type FreeBusyViewType string
+
+func (s FreeBusyViewType) String() string { return string(s) }
+
+type LegacyFreeBusyType string
+
+func (s LegacyFreeBusyType) String() string { return string(s) }
+
+type SuggestionQuality string
+
+func (s SuggestionQuality) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	AttendeeType_Organizer AttendeeType = "Organizer"
+	AttendeeType_Required  AttendeeType = "Required"
+	AttendeeType_Optional  AttendeeType = "Optional"
+	AttendeeType_Room      AttendeeType = "Room"
+	AttendeeType_Resource  AttendeeType = "Resource"
+
+	FreeBusyViewType_None           FreeBusyViewType = "None"
+	FreeBusyViewType_MergedOnly     FreeBusyViewType = "MergedOnly"
+	FreeBusyViewType_FreeBusy       FreeBusyViewType = "FreeBusy"
+	FreeBusyViewType_FreeBusyMerged FreeBusyViewType = "FreeBusyMerged"
+	FreeBusyViewType_Detailed       FreeBusyViewType = "Detailed"
+	FreeBusyViewType_DetailedMerged FreeBusyViewType = "DetailedMerged"
+
+	LegacyFreeBusyType_Free             LegacyFreeBusyType = "Free"
+	LegacyFreeBusyType_Tentative        LegacyFreeBusyType = "Tentative"
+	LegacyFreeBusyType_Busy             LegacyFreeBusyType = "Busy"
+	LegacyFreeBusyType_OOF              LegacyFreeBusyType = "OOF"
+	LegacyFreeBusyType_WorkingElsewhere LegacyFreeBusyType = "WorkingElsewhere"
+	LegacyFreeBusyType_NoData           LegacyFreeBusyType = "NoData"
+
+	SuggestionQuality_Excellent SuggestionQuality = "Excellent"
+	SuggestionQuality_Good      SuggestionQuality = "Good"
+	SuggestionQuality_Fair      SuggestionQuality = "Fair"
+	SuggestionQuality_Poor      SuggestionQuality = "Poor"
+)
+
+// The GetUserAvailabilityRequest element contains the arguments used to
+// obtain user availability information.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuseravailabilityrequest
+type GetUserAvailabilityRequest struct {
+	XMLName             xml.Name             `xml:"m:GetUserAvailabilityRequest"`
+	TimeZone            TimeZone             `xml:"TimeZone"`
+	MailboxDataArray    []MailboxData        `xml:"m:MailboxDataArray>MailboxData"`
+	FreeBusyViewOptions *FreeBusyViewOptions `xml:",omitempty"`
+
+	SuggestionsViewOptions *SuggestionsViewOptions `xml:",omitempty"`
+}
+
+// The MailboxData element identifies a single user to get availability
+// information for.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/mailboxdata
+type MailboxData struct {
+	Email            Email
+	AttendeeType     AttendeeType
+	ExcludeConflicts bool `xml:",omitempty"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/email-emailaddresstype
+type Email struct {
+	Name        string `xml:",omitempty"`
+	Address     string
+	RoutingType string `xml:",omitempty"`
+}
+
+// The FreeBusyViewOptions element specifies the type of free/busy information
+// returned in the response.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/freebusyviewoptions
+type FreeBusyViewOptions struct {
+	TimeWindow                      TimeWindow
+	MergedFreeBusyIntervalInMinutes int `xml:",omitempty"`
+	RequestedView                   FreeBusyViewType
+}
+
+// The SuggestionsViewOptions element contains the options for obtaining
+// meeting suggestion information. GoodThreshold is the percentage of
+// attendees that must be available for a time slot to be considered good.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/suggestionsviewoptions
+type SuggestionsViewOptions struct {
+	GoodThreshold                  int               `xml:",omitempty"`
+	MaximumResultsByDay            int               `xml:",omitempty"`
+	MaximumNonWorkHourResultsByDay int               `xml:",omitempty"`
+	MeetingDurationInMinutes       int               `xml:",omitempty"`
+	MinimumSuggestionQuality       SuggestionQuality `xml:",omitempty"`
+	DetailedSuggestionsWindow      TimeWindow
+	CurrentMeetingTime             string `xml:",omitempty"`
+	GlobalObjectId                 string `xml:",omitempty"`
+}
+
+// The FreeBusyResponse element contains free/busy information for a single
+// mailbox user.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/freebusyresponse
+type FreeBusyResponse struct {
+	ResponseMessage ResponseMessage
+	FreeBusyView    FreeBusyView
+}
+
+func (r *FreeBusyResponse) Response() *ResponseMessage { return &r.ResponseMessage }
+
+// The FreeBusyView element contains availability information for a specific
+// user.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/freebusyview
+type FreeBusyView struct {
+	FreeBusyViewType   FreeBusyViewType
+	MergedFreeBusy     string          `xml:",omitempty"`
+	CalendarEventArray []CalendarEvent `xml:"CalendarEventArray>CalendarEvent"`
+	WorkingHours       *WorkingHours   `xml:",omitempty"`
+}
+
+// MergedFreeBusyTypes returns the LegacyFreeBusyType of each interval in
+// MergedFreeBusy.
+func (v *FreeBusyView) MergedFreeBusyTypes() []LegacyFreeBusyType {
+	res := make([]LegacyFreeBusyType, len(v.MergedFreeBusy))
+	for i, c := range v.MergedFreeBusy {
+		res[i] = mergedFreeBusyType(byte(c))
+	}
+	return res
+}
+
+func mergedFreeBusyType(c byte) LegacyFreeBusyType {
+	switch c {
+	case '0':
+		return LegacyFreeBusyType_Free
+	case '1':
+		return LegacyFreeBusyType_Tentative
+	case '2':
+		return LegacyFreeBusyType_Busy
+	case '3':
+		return LegacyFreeBusyType_OOF
+	case '4':
+		return LegacyFreeBusyType_WorkingElsewhere
+	default:
+		return LegacyFreeBusyType_NoData
+	}
+}
+
+// MergedFreeSlots returns the time windows in which all views are free,
+// according to their MergedFreeBusy string. The views must be requested with
+// the same window and intervalMinutes, using a FreeBusyViewType which
+// includes the merged view.
+func MergedFreeSlots(window TimeWindow, intervalMinutes int, views ...FreeBusyView) []TimeWindow {
+	if len(views) == 0 || intervalMinutes <= 0 {
+		return nil
+	}
+
+	n := len(views[0].MergedFreeBusy)
+	for _, v := range views[1:] {
+		if len(v.MergedFreeBusy) < n {
+			n = len(v.MergedFreeBusy)
+		}
+	}
+
+	interval := time.Duration(intervalMinutes) * time.Minute
+	var res []TimeWindow
+	var open bool
+
+	for i := 0; i < n; i++ {
+		free := true
+		for _, v := range views {
+			if v.MergedFreeBusy[i] != '0' {
+				free = false
+				break
+			}
+		}
+		if !free {
+			open = false
+			continue
+		}
+
+		start := window.StartTime.Add(time.Duration(i) * interval)
+		if open {
+			res[len(res)-1].EndTime = start.Add(interval)
+			continue
+		}
+
+		open = true
+		res = append(res, TimeWindow{StartTime: start, EndTime: start.Add(interval)})
+	}
+	return res
+}
+
+// The WorkingHours element represents the time zone settings and working
+// hours for the requested mailbox user.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/workinghours
+type WorkingHours struct {
+	TimeZone           TimeZone
+	WorkingPeriodArray []WorkingPeriod `xml:"WorkingPeriodArray>WorkingPeriod"`
+}
+
+// The WorkingPeriod element contains the work days and hours of the mailbox
+// user. DayOfWeek is a space separated list of days.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/workingperiod
+type WorkingPeriod struct {
+	DayOfWeek          string
+	StartTimeInMinutes int
+	EndTimeInMinutes   int
+}
+
+// The CalendarEvent element represents a unique calendar item occurrence.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/calendarevent
+type CalendarEvent struct {
+	StartTime            Time
+	EndTime              Time
+	BusyType             LegacyFreeBusyType
+	CalendarEventDetails *CalendarEventDetails `xml:",omitempty"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/calendareventdetails
+type CalendarEventDetails struct {
+	ID            string
+	Subject       string
+	Location      string
+	IsMeeting     bool
+	IsRecurring   bool
+	IsException   bool
+	IsReminderSet bool
+	IsPrivate     bool
+}
+
+// The SuggestionsResponse element contains response information and
+// suggestion data for requested meeting suggestions.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/suggestionsresponse
+type SuggestionsResponse struct {
+	ResponseMessage          ResponseMessage
+	SuggestionDayResultArray []SuggestionDayResult `xml:"SuggestionDayResultArray>SuggestionDayResult"`
+}
+
+func (r *SuggestionsResponse) Response() *ResponseMessage { return &r.ResponseMessage }
+
+// The SuggestionDayResult element represents a single day that contains
+// suggested meeting times.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/suggestiondayresult
+type SuggestionDayResult struct {
+	Date            Time
+	DayQuality      SuggestionQuality
+	SuggestionArray []Suggestion `xml:"SuggestionArray>Suggestion"`
+}
+
+// The Suggestion element represents a single meeting suggestion.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/suggestion
+type Suggestion struct {
+	MeetingTime               Time
+	IsWorkTime                bool
+	SuggestionQuality         SuggestionQuality
+	AttendeeConflictDataArray AttendeeConflictDataArray `xml:"AttendeeConflictDataArray"`
+}
+
+// ConflictCount returns the number of attendees that have a conflict with
+// the suggested meeting time. Members of groups are counted individually.
+func (s *Suggestion) ConflictCount() int {
+	var n int
+	for _, d := range s.AttendeeConflictDataArray.IndividualAttendeeConflictData {
+		if d.BusyType != LegacyFreeBusyType_Free {
+			n++
+		}
+	}
+	for _, d := range s.AttendeeConflictDataArray.GroupAttendeeConflictData {
+		n += d.NumberOfMembersWithConflict
+	}
+	return n
+}
+
+// The AttendeeConflictDataArray element contains an array of conflict data
+// for each attendee, in the order of the MailboxDataArray of the request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/attendeeconflictdataarray
+type AttendeeConflictDataArray struct {
+	UnknownAttendeeConflictData     []struct{}                       `xml:",omitempty"`
+	IndividualAttendeeConflictData  []IndividualAttendeeConflictData `xml:",omitempty"`
+	TooBigGroupAttendeeConflictData []struct{}                       `xml:",omitempty"`
+	GroupAttendeeConflictData       []GroupAttendeeConflictData      `xml:",omitempty"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/individualattendeeconflictdata
+type IndividualAttendeeConflictData struct {
+	BusyType LegacyFreeBusyType
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/groupattendeeconflictdata
+type GroupAttendeeConflictData struct {
+	NumberOfMembers             int
+	NumberOfMembersAvailable    int
+	NumberOfMembersWithConflict int
+	NumberOfMembersWithNoData   int
+}
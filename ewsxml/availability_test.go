@@ -0,0 +1,178 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserAvailabilityRequest(t *testing.T) {
+	start, _ := time.Parse(time.RFC3339, "2006-02-06T00:00:00Z")
+	end, _ := time.Parse(time.RFC3339, "2006-02-25T23:59:59Z")
+
+	req := GetUserAvailabilityRequest{
+		TimeZone: TimeZone{
+			Bias: 480,
+			StandardTime: TimeZoneTime{
+				Bias:      0,
+				Time:      "02:00:00",
+				DayOrder:  5,
+				Month:     10,
+				DayOfWeek: "Sunday",
+			},
+			DaylightTime: TimeZoneTime{
+				Bias:      -60,
+				Time:      "02:00:00",
+				DayOrder:  1,
+				Month:     4,
+				DayOfWeek: "Sunday",
+			},
+		},
+		MailboxDataArray: []MailboxData{{
+			Email: Email{
+				Address:     "someone@ExServer.example.com",
+				RoutingType: "SMTP",
+			},
+			AttendeeType: AttendeeType_Organizer,
+		}},
+		FreeBusyViewOptions: &FreeBusyViewOptions{
+			TimeWindow: TimeWindow{
+				StartTime: start,
+				EndTime:   end,
+			},
+			MergedFreeBusyIntervalInMinutes: 60,
+			RequestedView:                   FreeBusyViewType_FreeBusyMerged,
+		},
+	}
+
+	have, err := xml.MarshalIndent(req, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:GetUserAvailabilityRequest>
+  <TimeZone>
+    <Bias>480</Bias>
+    <StandardTime>
+      <Bias>0</Bias>
+      <Time>02:00:00</Time>
+      <DayOrder>5</DayOrder>
+      <Month>10</Month>
+      <DayOfWeek>Sunday</DayOfWeek>
+    </StandardTime>
+    <DaylightTime>
+      <Bias>-60</Bias>
+      <Time>02:00:00</Time>
+      <DayOrder>1</DayOrder>
+      <Month>4</Month>
+      <DayOfWeek>Sunday</DayOfWeek>
+    </DaylightTime>
+  </TimeZone>
+  <m:MailboxDataArray>
+    <MailboxData>
+      <Email>
+        <Address>someone@ExServer.example.com</Address>
+        <RoutingType>SMTP</RoutingType>
+      </Email>
+      <AttendeeType>Organizer</AttendeeType>
+    </MailboxData>
+  </m:MailboxDataArray>
+  <FreeBusyViewOptions>
+    <TimeWindow>
+      <StartTime>2006-02-06T00:00:00Z</StartTime>
+      <EndTime>2006-02-25T23:59:59Z</EndTime>
+    </TimeWindow>
+    <MergedFreeBusyIntervalInMinutes>60</MergedFreeBusyIntervalInMinutes>
+    <RequestedView>FreeBusyMerged</RequestedView>
+  </FreeBusyViewOptions>
+</m:GetUserAvailabilityRequest>`, string(have))
+}
+
+func TestFreeBusyResponse(t *testing.T) {
+	const data = `<FreeBusyResponse>
+  <ResponseMessage ResponseClass="Error">
+    <MessageText>Unable to resolve e-mail address someone@ExServer.example.com to an Active Directory object.</MessageText>
+    <ResponseCode>ErrorMailRecipientNotFound</ResponseCode>
+    <DescriptiveLinkKey>0</DescriptiveLinkKey>
+    <MessageXml>
+      <ExceptionType xmlns="http://schemas.microsoft.com/exchange/services/2006/errors">MailRecipientNotFoundException</ExceptionType>
+      <ExceptionCode xmlns="http://schemas.microsoft.com/exchange/services/2006/errors">5009</ExceptionCode>
+      <ExceptionServerName xmlns="http://schemas.microsoft.com/exchange/services/2006/errors">PR3PR01MB6473</ExceptionServerName>
+      <ExceptionMessage xmlns="http://schemas.microsoft.com/exchange/services/2006/errors">Unable to resolve e-mail address someone@ExServer.example.com to an Active Directory object. LID: 57660</ExceptionMessage>
+    </MessageXml>
+  </ResponseMessage>
+  <FreeBusyView>
+    <FreeBusyViewType xmlns="http://schemas.microsoft.com/exchange/services/2006/types">None</FreeBusyViewType>
+  </FreeBusyView>
+</FreeBusyResponse>`
+
+	var have FreeBusyResponse
+	assert.NoError(t, xml.Unmarshal([]byte(data), &have))
+	assert.Equal(t, ResponseClass_Error, have.Response().ResponseClass)
+	assert.Equal(t, ErrorMailRecipientNotFound, have.Response().ResponseCode)
+	assert.Equal(t,
+		`Unable to resolve e-mail address someone@ExServer.example.com to an Active Directory object. LID: 57660`,
+		have.Response().MessageXml.ExceptionMessage,
+	)
+	assert.Equal(t, FreeBusyViewType_None, have.FreeBusyView.FreeBusyViewType)
+}
+
+func TestMergedFreeSlots(t *testing.T) {
+	start := time.Date(2006, 2, 6, 8, 0, 0, 0, time.UTC)
+	window := TimeWindow{StartTime: start, EndTime: start.Add(6 * time.Hour)}
+	hour := func(h int) time.Time { return start.Add(time.Duration(h) * time.Hour) }
+
+	have := MergedFreeSlots(window, 60,
+		FreeBusyView{MergedFreeBusy: "002000"},
+		FreeBusyView{MergedFreeBusy: "000010"},
+	)
+	assert.Equal(t, []TimeWindow{
+		{StartTime: hour(0), EndTime: hour(2)},
+		{StartTime: hour(3), EndTime: hour(4)},
+		{StartTime: hour(5), EndTime: hour(6)},
+	}, have)
+
+	assert.Equal(t, []LegacyFreeBusyType{
+		LegacyFreeBusyType_Free,
+		LegacyFreeBusyType_Tentative,
+		LegacyFreeBusyType_NoData,
+	}, (&FreeBusyView{MergedFreeBusy: "01x"}).MergedFreeBusyTypes())
+}
+
+func TestSuggestionsResponse(t *testing.T) {
+	const data = `<SuggestionsResponse>
+  <ResponseMessage ResponseClass="Success"><ResponseCode>NoError</ResponseCode></ResponseMessage>
+  <SuggestionDayResultArray>
+    <SuggestionDayResult>
+      <Date>2006-02-08T00:00:00</Date>
+      <DayQuality>Excellent</DayQuality>
+      <SuggestionArray>
+        <Suggestion>
+          <MeetingTime>2006-02-08T10:00:00</MeetingTime>
+          <IsWorkTime>true</IsWorkTime>
+          <SuggestionQuality>Good</SuggestionQuality>
+          <AttendeeConflictDataArray>
+            <IndividualAttendeeConflictData><BusyType>Free</BusyType></IndividualAttendeeConflictData>
+            <IndividualAttendeeConflictData><BusyType>Busy</BusyType></IndividualAttendeeConflictData>
+            <GroupAttendeeConflictData>
+              <NumberOfMembers>10</NumberOfMembers>
+              <NumberOfMembersAvailable>8</NumberOfMembersAvailable>
+              <NumberOfMembersWithConflict>2</NumberOfMembersWithConflict>
+              <NumberOfMembersWithNoData>0</NumberOfMembersWithNoData>
+            </GroupAttendeeConflictData>
+          </AttendeeConflictDataArray>
+        </Suggestion>
+      </SuggestionArray>
+    </SuggestionDayResult>
+  </SuggestionDayResultArray>
+</SuggestionsResponse>`
+
+	var have SuggestionsResponse
+	assert.NoError(t, xml.Unmarshal([]byte(data), &have))
+	assert.Len(t, have.SuggestionDayResultArray, 1)
+
+	day := have.SuggestionDayResultArray[0]
+	assert.Equal(t, SuggestionQuality_Excellent, day.DayQuality)
+	assert.Len(t, day.SuggestionArray, 1)
+	assert.Equal(t, Time("2006-02-08T10:00:00"), day.SuggestionArray[0].MeetingTime)
+	assert.Equal(t, 3, day.SuggestionArray[0].ConflictCount())
+}
@@ -4,12 +4,6 @@ import (
 	"time"
 )
 
-// The Sensitivity element indicates the sensitivity level of an item.
-// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sensitivity
-type Sensitivity string
-
-func (s Sensitivity) String() string { return string(s) }
-
 // The LegacyFreeBusyStatus element represents the free/busy status of the
 // calendar item.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/legacyfreebusystatus
@@ -25,11 +19,6 @@ func (s CalendarItemType) String() string { return string(s) }
 
 //goland:noinspection GoUnusedConst,GoSnakeCaseUsage
 const (
-	Sensitivity_Normal       Sensitivity = "Normal"
-	Sensitivity_Personal     Sensitivity = "Personal"
-	Sensitivity_Private      Sensitivity = "Private"
-	Sensitivity_Confidential Sensitivity = "Confidential"
-
 	LegacyFreeBusyStatus_Free             LegacyFreeBusyStatus = "Free"
 	LegacyFreeBusyStatus_Tentative        LegacyFreeBusyStatus = "Tentative"
 	LegacyFreeBusyStatus_Busy             LegacyFreeBusyStatus = "Busy"
@@ -55,17 +44,18 @@ const (
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/calendaritem
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createitem-operation-calendar-item
 type CalendarItem struct {
-	// MimeContent                  string
-	ItemId         *ItemId `xml:",omitempty"`
-	ParentFolderId *ItemId `xml:",omitempty"`
-	// ItemClass                    string
-	Subject string
-	// Sensitivity *Sensitivity
-	Body *Body `xml:",omitempty"`
-	// Attachments                  string
+	MimeContent    *MimeContent `xml:",omitempty"`
+	ItemId         *ItemId      `xml:",omitempty"`
+	ParentFolderId *ItemId      `xml:",omitempty"`
+	ItemClass      string       `xml:",omitempty"`
+	Subject        string       `xml:",omitempty"`
+	Sensitivity    Sensitivity  `xml:",omitempty"`
+	Body           *Body        `xml:",omitempty"`
+	Attachments    *Attachments `xml:",omitempty"`
 	// DateTimeReceived             string
 	// Size                         string
-	// Categories                   string
+	Categories Categories `xml:",omitempty"`
+	Importance Importance `xml:",omitempty"`
 	// InReplyTo                    string
 	// IsSubmitted                  string
 	// IsDraft                      string
@@ -76,32 +66,32 @@ type CalendarItem struct {
 	// DateTimeSent                 string
 	// DateTimeCreated              string
 	// ResponseObjects              string
-	// ReminderDueBy                string
+	ReminderDueBy              EwsDateTime        `xml:",omitempty"`
 	ReminderIsSet              bool               `xml:",omitempty"`
 	ReminderMinutesBeforeStart Minutes            `xml:",omitempty"`
 	DisplayCc                  ConcatenatedString `xml:",omitempty"`
 	DisplayTo                  ConcatenatedString `xml:",omitempty"`
-	// HasAttachments             bool
-	// ExtendedProperty             string
+	HasAttachments             bool               `xml:",omitempty"`
+	ExtendedProperty           ExtendedProperties `xml:",omitempty"`
 	// Culture                      string
-	Start time.Time
-	End   time.Time
-	// OriginalStart                string
-	IsAllDayEvent        bool
+	Start                *time.Time            `xml:",omitempty"`
+	End                  *time.Time            `xml:",omitempty"`
+	OriginalStart        *time.Time            `xml:",omitempty"`
+	IsAllDayEvent        bool                  `xml:",omitempty"`
 	LegacyFreeBusyStatus *LegacyFreeBusyStatus `xml:",omitempty"`
 	Location             *string               `xml:",omitempty"`
 	// When                         string
-	// IsMeeting                    string
-	// IsCancelled                  string
-	// IsRecurring                  string
+	IsMeeting   bool `xml:",omitempty"`
+	IsCancelled bool `xml:",omitempty"`
+	IsRecurring bool `xml:",omitempty"`
 	// MeetingRequestWasSent        string
 	// IsResponseRequested          string
-	CalendarItemType CalendarItemType `xml:",omitempty"`
-	// MyResponseType               string
-	Organizer         *Mailbox   `xml:"Organizer>Mailbox,omitempty"`
-	RequiredAttendees *Attendees `xml:",omitempty"`
-	OptionalAttendees *Attendees `xml:",omitempty"`
-	// Resources         []Attendee // []Attendees
+	CalendarItemType  CalendarItemType `xml:",omitempty"`
+	MyResponseType    ResponseType     `xml:",omitempty"`
+	Organizer         *Mailbox         `xml:"Organizer>Mailbox,omitempty"`
+	RequiredAttendees *Attendees       `xml:",omitempty"`
+	OptionalAttendees *Attendees       `xml:",omitempty"`
+	Resources         *Attendees       `xml:",omitempty"`
 	// ConflictingMeetingCount      string
 	// AdjacentMeetingCount         string
 	// ConflictingMeetings          string
@@ -111,11 +101,11 @@ type CalendarItem struct {
 	// AppointmentReplyTime         string
 	// AppointmentSequenceNumber    string
 	// AppointmentState             string
-	// Recurrence                   string
-	// FirstOccurrence              string
-	// LastOccurrence               string
-	// ModifiedOccurrences          string
-	// DeletedOccurrences           string
+	Recurrence          *Recurrence              `xml:",omitempty"`
+	FirstOccurrence     *OccurrenceInfo          `xml:",omitempty"`
+	LastOccurrence      *OccurrenceInfo          `xml:",omitempty"`
+	ModifiedOccurrences *[]OccurrenceInfo        `xml:"ModifiedOccurrences>Occurrence,omitempty"`
+	DeletedOccurrences  *[]DeletedOccurrenceInfo `xml:"DeletedOccurrences>DeletedOccurrence,omitempty"`
 	// MeetingTimeZone              string
 	// StartTimeZone                string
 	// EndTimeZone                  string
@@ -0,0 +1,133 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarItem_MarshalXML(t *testing.T) {
+	busy := LegacyFreeBusyStatus_Busy
+	location := "Conference Room 721"
+	start := time.Date(2006, 11, 2, 14, 0, 0, 0, time.UTC)
+	end := time.Date(2006, 11, 2, 15, 0, 0, 0, time.UTC)
+
+	item := CalendarItem{
+		Subject:                    "Planning Meeting",
+		Body:                       &Body{BodyType: BodyType_Text, Contents: []byte("Plan the agenda for next week's meeting.")},
+		ReminderIsSet:              true,
+		ReminderMinutesBeforeStart: Minutes(60 * time.Minute),
+		Start:                      &start,
+		End:                        &end,
+		LegacyFreeBusyStatus:       &busy,
+		Location:                   &location,
+		RequiredAttendees: NewAttendees(
+			Attendee{Mailbox: Mailbox{EmailAddress: "User1@example.com"}},
+			Attendee{Mailbox: Mailbox{EmailAddress: "User2@example.com"}},
+		),
+	}
+
+	have, err := xml.MarshalIndent(item, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<CalendarItem>
+  <Subject>Planning Meeting</Subject>
+  <Body BodyType="Text" IsTruncated="false">Plan the agenda for next week&#39;s meeting.</Body>
+  <ReminderIsSet>true</ReminderIsSet>
+  <ReminderMinutesBeforeStart>60</ReminderMinutesBeforeStart>
+  <Start>2006-11-02T14:00:00Z</Start>
+  <End>2006-11-02T15:00:00Z</End>
+  <LegacyFreeBusyStatus>Busy</LegacyFreeBusyStatus>
+  <Location>Conference Room 721</Location>
+  <RequiredAttendees>
+    <Attendee>
+      <Mailbox>
+        <EmailAddress>User1@example.com</EmailAddress>
+      </Mailbox>
+    </Attendee>
+    <Attendee>
+      <Mailbox>
+        <EmailAddress>User2@example.com</EmailAddress>
+      </Mailbox>
+    </Attendee>
+  </RequiredAttendees>
+</CalendarItem>`, string(have))
+}
+
+func TestCalendarItem_UnmarshalXML(t *testing.T) {
+	const data = `<t:CalendarItem xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:ItemId Id="AAAlAF" ChangeKey="DwAAAB" />
+  <t:Subject>Weekly standup</t:Subject>
+  <t:Sensitivity>Private</t:Sensitivity>
+  <t:Importance>High</t:Importance>
+  <t:ReminderDueBy>2023-06-05T07:00:00Z</t:ReminderDueBy>
+  <t:ReminderIsSet>true</t:ReminderIsSet>
+  <t:ReminderMinutesBeforeStart>15</t:ReminderMinutesBeforeStart>
+  <t:Start>2023-06-05T07:00:00Z</t:Start>
+  <t:End>2023-06-05T07:15:00Z</t:End>
+  <t:LegacyFreeBusyStatus>Busy</t:LegacyFreeBusyStatus>
+  <t:Location>Room 1</t:Location>
+  <t:IsMeeting>true</t:IsMeeting>
+  <t:IsRecurring>true</t:IsRecurring>
+  <t:CalendarItemType>RecurringMaster</t:CalendarItemType>
+  <t:MyResponseType>Organizer</t:MyResponseType>
+  <t:Organizer><t:Mailbox><t:Name>John</t:Name><t:EmailAddress>john@example.com</t:EmailAddress></t:Mailbox></t:Organizer>
+  <t:RequiredAttendees>
+    <t:Attendee><t:Mailbox><t:EmailAddress>jane@example.com</t:EmailAddress></t:Mailbox><t:ResponseType>Accept</t:ResponseType></t:Attendee>
+  </t:RequiredAttendees>
+  <t:Resources>
+    <t:Attendee><t:Mailbox><t:EmailAddress>room1@example.com</t:EmailAddress></t:Mailbox></t:Attendee>
+  </t:Resources>
+</t:CalendarItem>`
+
+	var ci CalendarItem
+	assert.NoError(t, xml.Unmarshal([]byte(data), &ci))
+	assert.Equal(t, "Weekly standup", ci.Subject)
+	assert.Equal(t, Sensitivity_Private, ci.Sensitivity)
+	assert.Equal(t, Importance_High, ci.Importance)
+	assert.True(t, ci.ReminderIsSet)
+	assert.Equal(t, 15*time.Minute, ci.ReminderMinutesBeforeStart.Duration())
+	assert.Equal(t, time.Date(2023, 6, 5, 7, 0, 0, 0, time.UTC), ci.ReminderDueBy.Time)
+	assert.Equal(t, time.Date(2023, 6, 5, 7, 0, 0, 0, time.UTC), *ci.Start)
+	assert.Equal(t, LegacyFreeBusyStatus_Busy, *ci.LegacyFreeBusyStatus)
+	assert.Equal(t, "Room 1", *ci.Location)
+	assert.True(t, ci.IsMeeting)
+	assert.True(t, ci.IsRecurring)
+	assert.Equal(t, ResponseType_Organizer, ci.MyResponseType)
+	assert.Equal(t, &Mailbox{Name: "John", EmailAddress: "john@example.com"}, ci.Organizer)
+	assert.Equal(t, ResponseType_Accept, ci.RequiredAttendees.Attendee[0].ResponseType)
+	assert.Equal(t, "room1@example.com", ci.Resources.Attendee[0].Mailbox.EmailAddress)
+}
+
+func TestCalendarItem_Occurrences(t *testing.T) {
+	const data = `<t:CalendarItem xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:ItemId Id="AAAlAF" ChangeKey="DwAAAB" />
+  <t:CalendarItemType>RecurringMaster</t:CalendarItemType>
+  <t:FirstOccurrence>
+    <t:ItemId Id="AAAlAG" ChangeKey="DwAAAB" />
+    <t:Start>2023-06-05T07:00:00Z</t:Start>
+    <t:End>2023-06-05T07:15:00Z</t:End>
+    <t:OriginalStart>2023-06-05T07:00:00Z</t:OriginalStart>
+  </t:FirstOccurrence>
+  <t:ModifiedOccurrences>
+    <t:Occurrence>
+      <t:ItemId Id="AAAlAH" ChangeKey="DwAAAB" />
+      <t:Start>2023-06-13T08:00:00Z</t:Start>
+      <t:End>2023-06-13T08:15:00Z</t:End>
+      <t:OriginalStart>2023-06-12T07:00:00Z</t:OriginalStart>
+    </t:Occurrence>
+  </t:ModifiedOccurrences>
+  <t:DeletedOccurrences>
+    <t:DeletedOccurrence><t:Start>2023-06-19T07:00:00Z</t:Start></t:DeletedOccurrence>
+  </t:DeletedOccurrences>
+</t:CalendarItem>`
+
+	var ci CalendarItem
+	assert.NoError(t, xml.Unmarshal([]byte(data), &ci))
+	assert.Equal(t, ItemId{Id: "AAAlAG", ChangeKey: "DwAAAB"}, ci.FirstOccurrence.ItemId)
+	assert.Nil(t, ci.LastOccurrence)
+	assert.Len(t, *ci.ModifiedOccurrences, 1)
+	assert.Equal(t, time.Date(2023, 6, 12, 7, 0, 0, 0, time.UTC), (*ci.ModifiedOccurrences)[0].OriginalStart)
+	assert.Equal(t, []DeletedOccurrenceInfo{{Start: time.Date(2023, 6, 19, 7, 0, 0, 0, time.UTC)}}, *ci.DeletedOccurrences)
+}
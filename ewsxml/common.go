@@ -1,6 +1,7 @@
 package ewsxml
 
 import (
+	"encoding/xml"
 	"strings"
 )
 
@@ -15,3 +16,25 @@ func (c ConcatenatedString) Split(sep string) []string {
 }
 
 func (s ConcatenatedString) String() string { return string(s) }
+
+// The Categories element contains a collection of strings that identify the
+// categories to which an item in the mailbox belongs.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/categories-ex15websvcsotherref
+type Categories struct {
+	String []string
+}
+
+func NewCategories(c ...string) Categories {
+	return Categories{String: c}
+}
+
+// MarshalXML omits the Categories element when it does not contain any
+// categories.
+func (c Categories) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(c.String) == 0 {
+		return nil
+	}
+
+	type categories Categories
+	return e.EncodeElement(categories(c), start)
+}
@@ -0,0 +1,221 @@
+package ewsxml
+
+import (
+	"time"
+)
+
+// The Key attribute of an EmailAddresses Entry element.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/entry-emailaddress
+type EmailAddressKey string
+
+func (s EmailAddressKey) String() string { return string(s) }
+
+// The Key attribute of a PhysicalAddresses Entry element.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/entry-physicaladdress
+type PhysicalAddressKey string
+
+func (s PhysicalAddressKey) String() string { return string(s) }
+
+// The Key attribute of a PhoneNumbers Entry element.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/entry-phonenumber
+type PhoneNumberKey string
+
+func (s PhoneNumberKey) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	EmailAddressKey_EmailAddress1 EmailAddressKey = "EmailAddress1"
+	EmailAddressKey_EmailAddress2 EmailAddressKey = "EmailAddress2"
+	EmailAddressKey_EmailAddress3 EmailAddressKey = "EmailAddress3"
+
+	PhysicalAddressKey_Home     PhysicalAddressKey = "Home"
+	PhysicalAddressKey_Business PhysicalAddressKey = "Business"
+	PhysicalAddressKey_Other    PhysicalAddressKey = "Other"
+
+	PhoneNumberKey_AssistantPhone   PhoneNumberKey = "AssistantPhone"
+	PhoneNumberKey_BusinessFax      PhoneNumberKey = "BusinessFax"
+	PhoneNumberKey_BusinessPhone    PhoneNumberKey = "BusinessPhone"
+	PhoneNumberKey_BusinessPhone2   PhoneNumberKey = "BusinessPhone2"
+	PhoneNumberKey_Callback         PhoneNumberKey = "Callback"
+	PhoneNumberKey_CarPhone         PhoneNumberKey = "CarPhone"
+	PhoneNumberKey_CompanyMainPhone PhoneNumberKey = "CompanyMainPhone"
+	PhoneNumberKey_HomeFax          PhoneNumberKey = "HomeFax"
+	PhoneNumberKey_HomePhone        PhoneNumberKey = "HomePhone"
+	PhoneNumberKey_HomePhone2       PhoneNumberKey = "HomePhone2"
+	PhoneNumberKey_Isdn             PhoneNumberKey = "Isdn"
+	PhoneNumberKey_MobilePhone      PhoneNumberKey = "MobilePhone"
+	PhoneNumberKey_OtherFax         PhoneNumberKey = "OtherFax"
+	PhoneNumberKey_OtherTelephone   PhoneNumberKey = "OtherTelephone"
+	PhoneNumberKey_Pager            PhoneNumberKey = "Pager"
+	PhoneNumberKey_PrimaryPhone     PhoneNumberKey = "PrimaryPhone"
+	PhoneNumberKey_RadioPhone       PhoneNumberKey = "RadioPhone"
+	PhoneNumberKey_Telex            PhoneNumberKey = "Telex"
+	PhoneNumberKey_TtyTddPhone      PhoneNumberKey = "TtyTddPhone"
+)
+
+// The Contact element represents a contact item in the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/contact
+type Contact struct {
+	MimeContent      *MimeContent       `xml:",omitempty"`
+	ItemId           *ItemId            `xml:",omitempty"`
+	ParentFolderId   *ItemId            `xml:",omitempty"`
+	ItemClass        string             `xml:",omitempty"`
+	Subject          string             `xml:",omitempty"`
+	Sensitivity      Sensitivity        `xml:",omitempty"`
+	Body             *Body              `xml:",omitempty"`
+	Attachments      *Attachments       `xml:",omitempty"`
+	Categories       Categories         `xml:",omitempty"`
+	HasAttachments   bool               `xml:",omitempty"`
+	ExtendedProperty ExtendedProperties `xml:",omitempty"`
+	FileAs           string             `xml:",omitempty"`
+	// FileAsMapping      string
+	DisplayName string `xml:",omitempty"`
+	GivenName   string `xml:",omitempty"`
+	Initials    string `xml:",omitempty"`
+	MiddleName  string `xml:",omitempty"`
+	Nickname    string `xml:",omitempty"`
+	// CompleteName       string
+	CompanyName       string                     `xml:",omitempty"`
+	EmailAddresses    *EmailAddressDictionary    `xml:",omitempty"`
+	PhysicalAddresses *PhysicalAddressDictionary `xml:",omitempty"`
+	PhoneNumbers      *PhoneNumberDictionary     `xml:",omitempty"`
+	AssistantName     string                     `xml:",omitempty"`
+	Birthday          *time.Time                 `xml:",omitempty"`
+	BusinessHomePage  string                     `xml:",omitempty"`
+	// Children           string
+	// Companies          string
+	// ContactSource      string
+	Department string `xml:",omitempty"`
+	Generation string `xml:",omitempty"`
+	// ImAddresses        string
+	JobTitle       string `xml:",omitempty"`
+	Manager        string `xml:",omitempty"`
+	Mileage        string `xml:",omitempty"`
+	OfficeLocation string `xml:",omitempty"`
+	// PostalAddressIndex string
+	Profession string `xml:",omitempty"`
+	SpouseName string `xml:",omitempty"`
+	Surname    string `xml:",omitempty"`
+	// WeddingAnniversary string
+}
+
+// The EmailAddresses element represents a collection of e-mail addresses for
+// a contact.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/emailaddresses
+type EmailAddressDictionary struct {
+	Entry []EmailAddressEntry
+}
+
+// Get returns the email address with the provided key.
+func (d *EmailAddressDictionary) Get(key EmailAddressKey) (string, bool) {
+	if d == nil {
+		return "", false
+	}
+	for _, e := range d.Entry {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set adds or replaces the email address with the provided key.
+func (d *EmailAddressDictionary) Set(key EmailAddressKey, email string) *EmailAddressDictionary {
+	for i, e := range d.Entry {
+		if e.Key == key {
+			d.Entry[i].Value = email
+			return d
+		}
+	}
+	d.Entry = append(d.Entry, EmailAddressEntry{Key: key, Value: email})
+	return d
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/entry-emailaddress
+type EmailAddressEntry struct {
+	Key         EmailAddressKey `xml:",attr"`
+	Name        string          `xml:",attr,omitempty"`
+	RoutingType RoutingType     `xml:",attr,omitempty"`
+	MailboxType MailboxType     `xml:",attr,omitempty"`
+	Value       string          `xml:",chardata"`
+}
+
+// The PhysicalAddresses element contains a collection of physical addresses
+// that are associated with a contact.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/physicaladdresses
+type PhysicalAddressDictionary struct {
+	Entry []PhysicalAddressEntry
+}
+
+// Get returns the physical address with the provided key.
+func (d *PhysicalAddressDictionary) Get(key PhysicalAddressKey) (PhysicalAddressEntry, bool) {
+	if d == nil {
+		return PhysicalAddressEntry{}, false
+	}
+	for _, e := range d.Entry {
+		if e.Key == key {
+			return e, true
+		}
+	}
+	return PhysicalAddressEntry{}, false
+}
+
+// Set adds or replaces the physical address with the Key of entry.
+func (d *PhysicalAddressDictionary) Set(entry PhysicalAddressEntry) *PhysicalAddressDictionary {
+	for i, e := range d.Entry {
+		if e.Key == entry.Key {
+			d.Entry[i] = entry
+			return d
+		}
+	}
+	d.Entry = append(d.Entry, entry)
+	return d
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/entry-physicaladdress
+type PhysicalAddressEntry struct {
+	Key             PhysicalAddressKey `xml:",attr"`
+	Street          string             `xml:",omitempty"`
+	City            string             `xml:",omitempty"`
+	State           string             `xml:",omitempty"`
+	CountryOrRegion string             `xml:",omitempty"`
+	PostalCode      string             `xml:",omitempty"`
+}
+
+// The PhoneNumbers element represents a collection of telephone numbers for a
+// contact.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/phonenumbers
+type PhoneNumberDictionary struct {
+	Entry []PhoneNumberEntry
+}
+
+// Get returns the phone number with the provided key.
+func (d *PhoneNumberDictionary) Get(key PhoneNumberKey) (string, bool) {
+	if d == nil {
+		return "", false
+	}
+	for _, e := range d.Entry {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set adds or replaces the phone number with the provided key.
+func (d *PhoneNumberDictionary) Set(key PhoneNumberKey, number string) *PhoneNumberDictionary {
+	for i, e := range d.Entry {
+		if e.Key == key {
+			d.Entry[i].Value = number
+			return d
+		}
+	}
+	d.Entry = append(d.Entry, PhoneNumberEntry{Key: key, Value: number})
+	return d
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/entry-phonenumber
+type PhoneNumberEntry struct {
+	Key   PhoneNumberKey `xml:",attr"`
+	Value string         `xml:",chardata"`
+}
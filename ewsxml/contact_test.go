@@ -0,0 +1,67 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContact(t *testing.T) {
+	contact := Contact{
+		GivenName:      "Jane",
+		CompanyName:    "Contoso",
+		EmailAddresses: new(EmailAddressDictionary).Set(EmailAddressKey_EmailAddress1, "jane@contoso.com"),
+		PhysicalAddresses: new(PhysicalAddressDictionary).Set(PhysicalAddressEntry{
+			Key:    PhysicalAddressKey_Business,
+			Street: "1 Main St",
+			City:   "Redmond",
+		}),
+		PhoneNumbers: new(PhoneNumberDictionary).
+			Set(PhoneNumberKey_MobilePhone, "555-0100").
+			Set(PhoneNumberKey_BusinessPhone, "555-0101"),
+		JobTitle: "Engineer",
+		Surname:  "Doe",
+	}
+
+	create := CreateItem{Items: Items{Contact: []Contact{contact}}}
+	x, err := xml.MarshalIndent(create, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CreateItem>
+  <m:Items>
+    <Contact>
+      <GivenName>Jane</GivenName>
+      <CompanyName>Contoso</CompanyName>
+      <EmailAddresses>
+        <Entry Key="EmailAddress1">jane@contoso.com</Entry>
+      </EmailAddresses>
+      <PhysicalAddresses>
+        <Entry Key="Business">
+          <Street>1 Main St</Street>
+          <City>Redmond</City>
+        </Entry>
+      </PhysicalAddresses>
+      <PhoneNumbers>
+        <Entry Key="MobilePhone">555-0100</Entry>
+        <Entry Key="BusinessPhone">555-0101</Entry>
+      </PhoneNumbers>
+      <JobTitle>Engineer</JobTitle>
+      <Surname>Doe</Surname>
+    </Contact>
+  </m:Items>
+</m:CreateItem>`, string(x))
+
+	x, err = xml.Marshal(contact)
+	assert.NoError(t, err)
+
+	var have Contact
+	assert.NoError(t, xml.Unmarshal(x, &have))
+	assert.Equal(t, contact, have)
+
+	email, ok := have.EmailAddresses.Get(EmailAddressKey_EmailAddress1)
+	assert.True(t, ok)
+	assert.Equal(t, "jane@contoso.com", email)
+
+	_, ok = have.EmailAddresses.Get(EmailAddressKey_EmailAddress2)
+	assert.False(t, ok)
+}
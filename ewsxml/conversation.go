@@ -0,0 +1,198 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type ConversationNodeSortOrder string
+
+func (s ConversationNodeSortOrder) String() string { return string(s) }
+
+type ConversationActionType string
+
+func (s ConversationActionType) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	ConversationNodeSortOrder_TreeOrderAscending  ConversationNodeSortOrder = "TreeOrderAscending"
+	ConversationNodeSortOrder_TreeOrderDescending ConversationNodeSortOrder = "TreeOrderDescending"
+	ConversationNodeSortOrder_DateOrderAscending  ConversationNodeSortOrder = "DateOrderAscending"
+	ConversationNodeSortOrder_DateOrderDescending ConversationNodeSortOrder = "DateOrderDescending"
+
+	// ConversationActionType_AlwaysCategorize applies Categories to current
+	// and future items of the conversation.
+	ConversationActionType_AlwaysCategorize ConversationActionType = "AlwaysCategorize"
+	// ConversationActionType_AlwaysDelete deletes current and future items of
+	// the conversation.
+	ConversationActionType_AlwaysDelete ConversationActionType = "AlwaysDelete"
+	// ConversationActionType_AlwaysMove moves current and future items of the
+	// conversation to DestinationFolderId.
+	ConversationActionType_AlwaysMove ConversationActionType = "AlwaysMove"
+	// ConversationActionType_Delete deletes the current items of the
+	// conversation using DeleteType.
+	ConversationActionType_Delete ConversationActionType = "Delete"
+	// ConversationActionType_Move moves the current items of the conversation
+	// to DestinationFolderId.
+	ConversationActionType_Move ConversationActionType = "Move"
+	// ConversationActionType_Copy copies the current items of the
+	// conversation to DestinationFolderId.
+	ConversationActionType_Copy ConversationActionType = "Copy"
+	// ConversationActionType_SetReadState sets the read state of the current
+	// items of the conversation to IsRead.
+	ConversationActionType_SetReadState ConversationActionType = "SetReadState"
+	// ConversationActionType_SetRetentionPolicy sets the retention policy of
+	// the conversation.
+	ConversationActionType_SetRetentionPolicy ConversationActionType = "SetRetentionPolicy"
+	// ConversationActionType_Flag sets the flag of the current items of the
+	// conversation.
+	ConversationActionType_Flag ConversationActionType = "Flag"
+)
+
+// The ConversationId element contains the identifier of a conversation.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/conversationid
+type ConversationId struct {
+	Id        string `xml:",attr"`
+	ChangeKey string `xml:",attr,omitempty"`
+}
+
+// The FindConversation element defines a request to find the conversations
+// within a folder. Only one of DistinguishedFolderId and FolderId should be
+// set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/findconversation
+type FindConversation struct {
+	XMLName               xml.Name               `xml:"m:FindConversation"`
+	IndexedPageItemView   *IndexedPageItemView   `xml:",omitempty"`
+	SortOrder             *SortOrder             `xml:"m:SortOrder,omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:"m:ParentFolderId>DistinguishedFolderId,omitempty"`
+	FolderId              *FolderId              `xml:"m:ParentFolderId>FolderId,omitempty"`
+	QueryString           *string                `xml:"m:QueryString,omitempty"`
+}
+
+// The Conversation element contains the summary of a single conversation
+// which is returned by FindConversation.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/conversation-ex15websvcsotherref
+type Conversation struct {
+	ConversationId     ConversationId
+	ConversationTopic  string
+	UniqueRecipients   []string `xml:"UniqueRecipients>String"`
+	UniqueSenders      []string `xml:"UniqueSenders>String"`
+	LastDeliveryTime   time.Time
+	Categories         Categories
+	HasAttachments     bool
+	MessageCount       int
+	GlobalMessageCount int
+	UnreadCount        int
+	GlobalUnreadCount  int
+	Size               int
+	ItemIds            []ItemId `xml:"ItemIds>ItemId"`
+	Importance         Importance
+}
+
+// The GetConversationItems element defines a request to get the items, as
+// conversation nodes, of one or more conversations.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getconversationitems
+type GetConversationItems struct {
+	XMLName          xml.Name `xml:"m:GetConversationItems"`
+	ItemShape        ItemShape
+	FoldersToIgnore  *FolderIds                `xml:"m:FoldersToIgnore,omitempty"`
+	MaxItemsToReturn int                       `xml:"m:MaxItemsToReturn,omitempty"`
+	SortOrder        ConversationNodeSortOrder `xml:"m:SortOrder,omitempty"`
+	Conversations    []ConversationRequest     `xml:"m:Conversations>Conversation"`
+}
+
+// ConversationRequest identifies a conversation of which the items are
+// requested. When SyncState is set, only the changes since the previous
+// request are returned.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/conversation-conversationrequesttype
+type ConversationRequest struct {
+	ConversationId ConversationId
+	SyncState      string `xml:",omitempty"`
+}
+
+// The GetConversationItemsResponseMessage element contains the status and
+// result of a single conversation of a GetConversationItems request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getconversationitemsresponsemessage
+type GetConversationItemsResponseMessage struct {
+	ResponseMessage
+	Conversation ConversationResponse
+}
+
+// ConversationResponse contains the nodes of a single conversation. Its
+// SyncState can be used to request only the changes of the conversation in
+// a next GetConversationItems request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/conversation-conversationresponsetype
+type ConversationResponse struct {
+	ConversationId    ConversationId
+	SyncState         string
+	ConversationNodes []ConversationNode `xml:"ConversationNodes>ConversationNode"`
+}
+
+// The ConversationNode element represents a single node of a conversation,
+// containing the items which share the same InternetMessageId.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/conversationnode
+type ConversationNode struct {
+	InternetMessageId       string
+	ParentInternetMessageId string
+	Items                   Items
+}
+
+// TargetFolderId identifies a folder which is the target of an action. Only
+// one of FolderId and DistinguishedFolderId should be set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/contextfolderid
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/destinationfolderid
+type TargetFolderId struct {
+	FolderId              *FolderId              `xml:",omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:",omitempty"`
+}
+
+// TargetFolder returns a TargetFolderId with the same folder id as f.
+func TargetFolder(f SavedItemFolderId) *TargetFolderId {
+	return &TargetFolderId{
+		FolderId:              f.FolderId,
+		DistinguishedFolderId: f.DistinguishedFolderId,
+	}
+}
+
+// MarshalXML returns an ErrMissingFolderId or ErrAmbiguousFolderId error
+// unless exactly one of FolderId and DistinguishedFolderId is set.
+func (t TargetFolderId) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := validateFolderId(t.FolderId, t.DistinguishedFolderId); err != nil {
+		return err
+	}
+
+	type targetFolderId TargetFolderId
+	return e.EncodeElement(targetFolderId(t), start)
+}
+
+// The ApplyConversationAction element defines a request to apply one or more
+// actions to conversations.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/applyconversationaction
+type ApplyConversationAction struct {
+	XMLName             xml.Name             `xml:"m:ApplyConversationAction"`
+	ConversationActions []ConversationAction `xml:"m:ConversationActions>ConversationAction"`
+}
+
+// The ConversationAction element contains a single action which is applied
+// to the conversation identified by ConversationId. When ContextFolderId is
+// set, the action only applies to the items of the conversation within that
+// folder.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/conversationaction
+type ConversationAction struct {
+	Action               ConversationActionType
+	ConversationId       ConversationId
+	ContextFolderId      *TargetFolderId `xml:",omitempty"`
+	DestinationFolderId  *TargetFolderId `xml:",omitempty"`
+	Categories           Categories      `xml:",omitempty"`
+	EnableAlwaysDelete   *bool           `xml:",omitempty"`
+	IsRead               *bool           `xml:",omitempty"`
+	DeleteType           DeleteType      `xml:",omitempty"`
+	SuppressReadReceipts bool            `xml:",omitempty"`
+}
+
+// The ApplyConversationActionResponseMessage element contains the status of
+// a single ConversationAction of an ApplyConversationAction request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/applyconversationactionresponsemessage
+type ApplyConversationActionResponseMessage struct {
+	ResponseMessage
+}
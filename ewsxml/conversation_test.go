@@ -0,0 +1,110 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindConversation_MarshalXML(t *testing.T) {
+	x, err := xml.Marshal(FindConversation{
+		IndexedPageItemView:   &IndexedPageItemView{MaxEntriesReturned: 10, BasePoint: BasePoint_Beginning},
+		DistinguishedFolderId: &DistinguishedFolderId{Id: DistinguishedFolderId_Inbox},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:FindConversation>`+
+		`<m:IndexedPageItemView MaxEntriesReturned="10" Offset="0" BasePoint="Beginning"></m:IndexedPageItemView>`+
+		`<m:ParentFolderId><DistinguishedFolderId Id="inbox"></DistinguishedFolderId></m:ParentFolderId>`+
+		`</m:FindConversation>`, string(x))
+}
+
+func TestConversation_UnmarshalXML(t *testing.T) {
+	const data = `<t:Conversation xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:ConversationId Id="AAQkAD" />
+  <t:ConversationTopic>Quarterly report</t:ConversationTopic>
+  <t:UniqueRecipients><t:String>John</t:String><t:String>Jane</t:String></t:UniqueRecipients>
+  <t:LastDeliveryTime>2023-06-01T09:00:00Z</t:LastDeliveryTime>
+  <t:MessageCount>3</t:MessageCount>
+  <t:UnreadCount>1</t:UnreadCount>
+  <t:ItemIds><t:ItemId Id="AAMkAD" ChangeKey="CQAAAB" /></t:ItemIds>
+</t:Conversation>`
+
+	var c Conversation
+	assert.NoError(t, xml.Unmarshal([]byte(data), &c))
+	assert.Equal(t, ConversationId{Id: "AAQkAD"}, c.ConversationId)
+	assert.Equal(t, "Quarterly report", c.ConversationTopic)
+	assert.Equal(t, []string{"John", "Jane"}, c.UniqueRecipients)
+	assert.Equal(t, time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC), c.LastDeliveryTime)
+	assert.Equal(t, 3, c.MessageCount)
+	assert.Equal(t, 1, c.UnreadCount)
+	assert.Equal(t, []ItemId{{Id: "AAMkAD", ChangeKey: "CQAAAB"}}, c.ItemIds)
+}
+
+func TestGetConversationItems(t *testing.T) {
+	x, err := xml.Marshal(GetConversationItems{
+		ItemShape:     ItemShape{BaseShape: BaseShape_IdOnly},
+		SortOrder:     ConversationNodeSortOrder_TreeOrderAscending,
+		Conversations: []ConversationRequest{{ConversationId: ConversationId{Id: "AAQkAD"}}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:GetConversationItems><m:ItemShape><BaseShape>IdOnly</BaseShape></m:ItemShape>`+
+		`<m:SortOrder>TreeOrderAscending</m:SortOrder>`+
+		`<m:Conversations><Conversation><ConversationId Id="AAQkAD"></ConversationId></Conversation></m:Conversations>`+
+		`</m:GetConversationItems>`, string(x))
+
+	const data = `<m:GetConversationItemsResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:Conversation>
+    <t:ConversationId Id="AAQkAD" />
+    <t:SyncState>AAAAAQ==</t:SyncState>
+    <t:ConversationNodes>
+      <t:ConversationNode>
+        <t:InternetMessageId>&lt;1@example.com&gt;</t:InternetMessageId>
+        <t:Items><t:Message><t:ItemId Id="AAMkAD" /><t:Subject>Quarterly report</t:Subject></t:Message></t:Items>
+      </t:ConversationNode>
+      <t:ConversationNode>
+        <t:InternetMessageId>&lt;2@example.com&gt;</t:InternetMessageId>
+        <t:ParentInternetMessageId>&lt;1@example.com&gt;</t:ParentInternetMessageId>
+        <t:Items><t:Message><t:ItemId Id="AAMkAE" /><t:Subject>RE: Quarterly report</t:Subject></t:Message></t:Items>
+      </t:ConversationNode>
+    </t:ConversationNodes>
+  </m:Conversation>
+</m:GetConversationItemsResponseMessage>`
+
+	var msg GetConversationItemsResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Equal(t, "AAAAAQ==", msg.Conversation.SyncState)
+	assert.Len(t, msg.Conversation.ConversationNodes, 2)
+	assert.Equal(t, "<1@example.com>", msg.Conversation.ConversationNodes[1].ParentInternetMessageId)
+	assert.Equal(t, []ItemId{{Id: "AAMkAE"}}, msg.Conversation.ConversationNodes[1].Items.ItemIds())
+}
+
+func TestApplyConversationAction_MarshalXML(t *testing.T) {
+	x, err := xml.MarshalIndent(ApplyConversationAction{
+		ConversationActions: []ConversationAction{{
+			Action:              ConversationActionType_Move,
+			ConversationId:      ConversationId{Id: "AAQkAD"},
+			ContextFolderId:     &TargetFolderId{DistinguishedFolderId: &DistinguishedFolderId{Id: DistinguishedFolderId_Inbox}},
+			DestinationFolderId: &TargetFolderId{FolderId: &FolderId{Id: "AAMkAF"}},
+		}},
+	}, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:ApplyConversationAction>
+  <m:ConversationActions>
+    <ConversationAction>
+      <Action>Move</Action>
+      <ConversationId Id="AAQkAD"></ConversationId>
+      <ContextFolderId>
+        <DistinguishedFolderId Id="inbox"></DistinguishedFolderId>
+      </ContextFolderId>
+      <DestinationFolderId>
+        <FolderId Id="AAMkAF"></FolderId>
+      </DestinationFolderId>
+    </ConversationAction>
+  </m:ConversationActions>
+</m:ApplyConversationAction>`, string(x))
+}
@@ -0,0 +1,54 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+type IdFormat string
+
+func (s IdFormat) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// IdFormat_EwsLegacyId describes Exchange 2007 SP1 EWS ids.
+	IdFormat_EwsLegacyId IdFormat = "EwsLegacyId"
+	// IdFormat_EwsId describes EWS ids.
+	IdFormat_EwsId IdFormat = "EwsId"
+	// IdFormat_EntryId describes MAPI PR_ENTRYID ids, base64 encoded.
+	IdFormat_EntryId IdFormat = "EntryId"
+	// IdFormat_HexEntryId describes MAPI PR_ENTRYID ids, hexadecimal encoded.
+	IdFormat_HexEntryId IdFormat = "HexEntryId"
+	// IdFormat_StoreId describes Exchange store ids.
+	IdFormat_StoreId IdFormat = "StoreId"
+	// IdFormat_OwaId describes Outlook Web App ids.
+	IdFormat_OwaId IdFormat = "OwaId"
+)
+
+// The ConvertId element defines a request to convert item and folder
+// identifiers between supported Exchange formats.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/convertid
+type ConvertId struct {
+	XMLName           xml.Name      `xml:"m:ConvertId"`
+	DestinationFormat IdFormat      `xml:",attr"`
+	SourceIds         []AlternateId `xml:"m:SourceIds>AlternateId"`
+}
+
+// The AlternateId element describes an identifier in an alternate format,
+// together with the primary SMTP address of the mailbox it belongs to.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/alternateid
+type AlternateId struct {
+	Format    IdFormat `xml:",attr"`
+	Id        string   `xml:",attr"`
+	Mailbox   string   `xml:",attr"`
+	IsArchive bool     `xml:",attr,omitempty"`
+}
+
+// ItemId returns the AlternateId as ItemId. It should only be used when
+// Format is EwsId.
+func (a AlternateId) ItemId() ItemId { return ItemId{Id: a.Id} }
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/convertidresponsemessage
+type ConvertIdResponseMessage struct {
+	ResponseMessage
+	AlternateId AlternateId
+}
@@ -0,0 +1,110 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/calendarfolderpermissionlevel
+type DelegateFolderPermissionLevel string
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	DelegateFolderPermissionLevel_None     DelegateFolderPermissionLevel = "None"
+	DelegateFolderPermissionLevel_Editor   DelegateFolderPermissionLevel = "Editor"
+	DelegateFolderPermissionLevel_Author   DelegateFolderPermissionLevel = "Author"
+	DelegateFolderPermissionLevel_Reviewer DelegateFolderPermissionLevel = "Reviewer"
+	DelegateFolderPermissionLevel_Custom   DelegateFolderPermissionLevel = "Custom"
+)
+
+// DeliverMeetingRequests defines how meeting requests are sent to the owner
+// of a mailbox and its delegates.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/delivermeetingrequests
+type DeliverMeetingRequests string
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	DeliverMeetingRequests_DelegatesOnly                   DeliverMeetingRequests = "DelegatesOnly"
+	DeliverMeetingRequests_DelegatesAndMe                  DeliverMeetingRequests = "DelegatesAndMe"
+	DeliverMeetingRequests_DelegatesAndSendInformationToMe DeliverMeetingRequests = "DelegatesAndSendInformationToMe"
+	DeliverMeetingRequests_NoForward                       DeliverMeetingRequests = "NoForward"
+)
+
+// The UserId element identifies a delegate user. Only one of its fields
+// should be set when used in a request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/userid
+type UserId struct {
+	SID                string `xml:",omitempty"`
+	PrimarySmtpAddress string `xml:",omitempty"`
+	DisplayName        string `xml:",omitempty"`
+}
+
+// The DelegatePermissions element contains the permission levels of a delegate
+// user on the default folders of the owner's mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/delegatepermissions
+type DelegatePermissions struct {
+	CalendarFolderPermissionLevel DelegateFolderPermissionLevel `xml:",omitempty"`
+	TasksFolderPermissionLevel    DelegateFolderPermissionLevel `xml:",omitempty"`
+	InboxFolderPermissionLevel    DelegateFolderPermissionLevel `xml:",omitempty"`
+	ContactsFolderPermissionLevel DelegateFolderPermissionLevel `xml:",omitempty"`
+	NotesFolderPermissionLevel    DelegateFolderPermissionLevel `xml:",omitempty"`
+	JournalFolderPermissionLevel  DelegateFolderPermissionLevel `xml:",omitempty"`
+}
+
+// The DelegateUser element identifies a single delegate and its permissions.
+// ReceiveCopiesOfMeetingMessages and ViewPrivateItems are pointers so they
+// can be explicitly disabled with UpdateDelegate.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/delegateuser
+type DelegateUser struct {
+	UserId                         UserId
+	DelegatePermissions            *DelegatePermissions `xml:",omitempty"`
+	ReceiveCopiesOfMeetingMessages *bool                `xml:",omitempty"`
+	ViewPrivateItems               *bool                `xml:",omitempty"`
+}
+
+// The GetDelegate element defines a request to get the delegates of the
+// Mailbox owner. All delegates are returned when UserIds is empty.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getdelegate
+type GetDelegate struct {
+	XMLName            xml.Name `xml:"m:GetDelegate"`
+	IncludePermissions bool     `xml:",attr"`
+	Mailbox            Mailbox  `xml:"m:Mailbox"`
+	UserIds            []UserId `xml:"m:UserIds>UserId,omitempty"`
+}
+
+// The AddDelegate element defines a request to add DelegateUsers to the
+// Mailbox owner.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/adddelegate
+type AddDelegate struct {
+	XMLName                xml.Name               `xml:"m:AddDelegate"`
+	Mailbox                Mailbox                `xml:"m:Mailbox"`
+	DelegateUsers          []DelegateUser         `xml:"m:DelegateUsers>DelegateUser"`
+	DeliverMeetingRequests DeliverMeetingRequests `xml:"m:DeliverMeetingRequests,omitempty"`
+}
+
+// The UpdateDelegate element defines a request to change the permissions of
+// existing DelegateUsers of the Mailbox owner.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updatedelegate
+type UpdateDelegate struct {
+	XMLName                xml.Name               `xml:"m:UpdateDelegate"`
+	Mailbox                Mailbox                `xml:"m:Mailbox"`
+	DelegateUsers          []DelegateUser         `xml:"m:DelegateUsers>DelegateUser,omitempty"`
+	DeliverMeetingRequests DeliverMeetingRequests `xml:"m:DeliverMeetingRequests,omitempty"`
+}
+
+// The RemoveDelegate element defines a request to remove delegates from the
+// Mailbox owner.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/removedelegate
+type RemoveDelegate struct {
+	XMLName xml.Name `xml:"m:RemoveDelegate"`
+	Mailbox Mailbox  `xml:"m:Mailbox"`
+	UserIds []UserId `xml:"m:UserIds>UserId"`
+}
+
+// The DelegateUserResponseMessageType element contains the status and result
+// of a single delegate. DelegateUser is not set in response to
+// RemoveDelegate.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/delegateuserresponsemessagetype
+type DelegateUserResponseMessage struct {
+	ResponseMessage
+	DelegateUser *DelegateUser `xml:",omitempty"`
+}
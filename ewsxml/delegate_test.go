@@ -0,0 +1,73 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddDelegate_MarshalXML(t *testing.T) {
+	no := false
+	x, err := xml.MarshalIndent(AddDelegate{
+		Mailbox: *EmailMailbox("owner@example.com"),
+		DelegateUsers: []DelegateUser{{
+			UserId: UserId{PrimarySmtpAddress: "assistant@example.com"},
+			DelegatePermissions: &DelegatePermissions{
+				CalendarFolderPermissionLevel: DelegateFolderPermissionLevel_Editor,
+				InboxFolderPermissionLevel:    DelegateFolderPermissionLevel_Reviewer,
+			},
+			ViewPrivateItems: &no,
+		}},
+		DeliverMeetingRequests: DeliverMeetingRequests_DelegatesAndMe,
+	}, "", "  ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:AddDelegate>
+  <m:Mailbox>
+    <EmailAddress>owner@example.com</EmailAddress>
+  </m:Mailbox>
+  <m:DelegateUsers>
+    <DelegateUser>
+      <UserId>
+        <PrimarySmtpAddress>assistant@example.com</PrimarySmtpAddress>
+      </UserId>
+      <DelegatePermissions>
+        <CalendarFolderPermissionLevel>Editor</CalendarFolderPermissionLevel>
+        <InboxFolderPermissionLevel>Reviewer</InboxFolderPermissionLevel>
+      </DelegatePermissions>
+      <ViewPrivateItems>false</ViewPrivateItems>
+    </DelegateUser>
+  </m:DelegateUsers>
+  <m:DeliverMeetingRequests>DelegatesAndMe</m:DeliverMeetingRequests>
+</m:AddDelegate>`, string(x))
+}
+
+func TestDelegateUserResponseMessage_UnmarshalXML(t *testing.T) {
+	const data = `<m:DelegateUserResponseMessageType ResponseClass="Success"
+  xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+  xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:DelegateUser>
+    <t:UserId>
+      <t:SID>S-1-5-21</t:SID>
+      <t:PrimarySmtpAddress>assistant@example.com</t:PrimarySmtpAddress>
+      <t:DisplayName>Assistant</t:DisplayName>
+    </t:UserId>
+    <t:DelegatePermissions>
+      <t:CalendarFolderPermissionLevel>Editor</t:CalendarFolderPermissionLevel>
+      <t:TasksFolderPermissionLevel>None</t:TasksFolderPermissionLevel>
+    </t:DelegatePermissions>
+    <t:ReceiveCopiesOfMeetingMessages>true</t:ReceiveCopiesOfMeetingMessages>
+    <t:ViewPrivateItems>false</t:ViewPrivateItems>
+  </m:DelegateUser>
+</m:DelegateUserResponseMessageType>`
+
+	var msg DelegateUserResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Equal(t, NoError, msg.ResponseCode)
+	assert.Equal(t, "Assistant", msg.DelegateUser.UserId.DisplayName)
+	assert.Equal(t, DelegateFolderPermissionLevel_Editor, msg.DelegateUser.DelegatePermissions.CalendarFolderPermissionLevel)
+	assert.True(t, *msg.DelegateUser.ReceiveCopiesOfMeetingMessages)
+	assert.False(t, *msg.DelegateUser.ViewPrivateItems)
+}
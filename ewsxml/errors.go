@@ -1,11 +1,31 @@
 package ewsxml
 
 // The ResponseCode element provides status information about the request.
+// ResponseCode implements error, so it can be used as target of errors.Is
+// to check the code of an ews.ResponseError or ews.SoapError.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/responsecode
 type ResponseCode string
 
 func (r ResponseCode) String() string { return string(r) }
 
+func (r ResponseCode) Error() string { return string(r) }
+
+// Transient indicates the request failed because of a temporary condition on
+// the server, so the same request may succeed when it is tried again later.
+func (r ResponseCode) Transient() bool {
+	switch r {
+	case ErrorServerBusy,
+		ErrorInternalServerTransientError,
+		ErrorADUnavailable,
+		ErrorMailboxStoreUnavailable,
+		ErrorMailboxMoveInProgress,
+		ErrorTooManyObjectsOpened:
+		return true
+	default:
+		return false
+	}
+}
+
 //goland:noinspection GoUnusedConst
 const (
 	// NoError indicates no error occurred for the request.
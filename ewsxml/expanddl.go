@@ -0,0 +1,32 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The ExpandDL element defines a request to expand a distribution list. The
+// distribution list is identified by either the EmailAddress or ItemId of
+// Mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/expanddl
+type ExpandDL struct {
+	XMLName xml.Name `xml:"m:ExpandDL"`
+	Mailbox Mailbox  `xml:"m:Mailbox"`
+}
+
+// The ExpandDLResponseMessage element contains the status and result of an
+// ExpandDL request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/expanddlresponsemessage
+type ExpandDLResponseMessage struct {
+	ResponseMessage
+	DLExpansion DLExpansion
+}
+
+// The DLExpansion element contains a list of the members of a distribution
+// list. Nested distribution lists are included as members with a MailboxType
+// of PublicDL or PrivateDL and can be expanded with another ExpandDL request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/dlexpansion
+type DLExpansion struct {
+	TotalItemsInView        int  `xml:",attr"`
+	IncludesLastItemInRange bool `xml:",attr"`
+	Mailbox                 []Mailbox
+}
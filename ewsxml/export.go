@@ -0,0 +1,84 @@
+package ewsxml
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+)
+
+// The ExportItems element defines a request to export items from a mailbox
+// as opaque data streams, which can be imported using UploadItems.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/exportitems
+type ExportItems struct {
+	XMLName xml.Name `xml:"m:ExportItems"`
+	ItemIds []ItemId `xml:"m:ItemIds>ItemId"`
+}
+
+// The ExportItemsResponseMessage element contains the status and result of
+// a single exported item. Data contains the base64 encoded data stream of the
+// item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/exportitemsresponsemessage
+type ExportItemsResponseMessage struct {
+	ResponseMessage
+	ItemId ItemId
+	Data   string
+}
+
+// Bytes returns the decoded data stream of the exported item.
+func (r ExportItemsResponseMessage) Bytes() ([]byte, error) {
+	if r.Data == "" {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(r.Data)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/item-uploaditemtype
+type CreateAction string
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// CreateAction_CreateNew creates a new item in the parent folder.
+	CreateAction_CreateNew CreateAction = "CreateNew"
+	// CreateAction_Update overwrites the existing item identified by
+	// UploadItem.ItemId.
+	CreateAction_Update CreateAction = "Update"
+	// CreateAction_UpdateOrCreate overwrites the existing item identified by
+	// UploadItem.ItemId, or creates a new item when it does not exist.
+	CreateAction_UpdateOrCreate CreateAction = "UpdateOrCreate"
+)
+
+// The UploadItems element defines a request to import items, which are
+// exported using ExportItems, into a mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/uploaditems
+type UploadItems struct {
+	XMLName xml.Name     `xml:"m:UploadItems"`
+	Items   []UploadItem `xml:"m:Items>Item"`
+}
+
+// The Item element represents a single item to upload. ItemId is only used
+// with CreateAction_Update and CreateAction_UpdateOrCreate.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/item-uploaditemtype
+type UploadItem struct {
+	CreateAction   CreateAction `xml:",attr"`
+	IsAssociated   bool         `xml:",attr,omitempty"`
+	ParentFolderId FolderId
+	ItemId         *ItemId `xml:",omitempty"`
+	Data           string
+}
+
+// NewUploadItem creates an UploadItem which creates a new item in the folder
+// identified by parent, with the base64 encoded data as its Data.
+func NewUploadItem(parent FolderId, data []byte) UploadItem {
+	return UploadItem{
+		CreateAction:   CreateAction_CreateNew,
+		ParentFolderId: parent,
+		Data:           base64.StdEncoding.EncodeToString(data),
+	}
+}
+
+// The UploadItemsResponseMessage element contains the status and the ItemId
+// of a single uploaded item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/uploaditemsresponsemessage
+type UploadItemsResponseMessage struct {
+	ResponseMessage
+	ItemId ItemId
+}
@@ -0,0 +1,36 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadItems_MarshalXML(t *testing.T) {
+	x, err := xml.MarshalIndent(UploadItems{Items: []UploadItem{
+		NewUploadItem(FolderId{Id: "AAMkAF"}, []byte("data")),
+	}}, "", "  ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:UploadItems>
+  <m:Items>
+    <Item CreateAction="CreateNew">
+      <ParentFolderId Id="AAMkAF"></ParentFolderId>
+      <Data>ZGF0YQ==</Data>
+    </Item>
+  </m:Items>
+</m:UploadItems>`, string(x))
+}
+
+func TestExportItemsResponseMessage_Bytes(t *testing.T) {
+	var msg ExportItemsResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(`<ExportItemsResponseMessage ResponseClass="Success">
+<ResponseCode>NoError</ResponseCode><ItemId Id="AAMkAD"/><Data>ZGF0YQ==</Data>
+</ExportItemsResponseMessage>`), &msg))
+
+	data, err := msg.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, "AAMkAD", msg.ItemId.Id)
+	assert.Equal(t, "data", string(data))
+}
@@ -2,125 +2,237 @@ package ewsxml
 
 import (
 	"encoding/xml"
+	"fmt"
+	"time"
 )
 
-type nodes []interface{}
+type ContainmentMode string
+
+func (s ContainmentMode) String() string { return string(s) }
+
+type ContainmentComparison string
+
+func (s ContainmentComparison) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// ContainmentMode_FullString indicates the comparison is between the full
+	// string and the constant.
+	ContainmentMode_FullString ContainmentMode = "FullString"
+	// ContainmentMode_Prefixed indicates the comparison is between the string
+	// prefix and the constant.
+	ContainmentMode_Prefixed ContainmentMode = "Prefixed"
+	// ContainmentMode_Substring indicates the comparison is between a
+	// substring of the string and the constant.
+	ContainmentMode_Substring ContainmentMode = "Substring"
+	// ContainmentMode_PrefixOnWords indicates the comparison is between a
+	// prefix on individual words in the string and the constant.
+	ContainmentMode_PrefixOnWords ContainmentMode = "PrefixOnWords"
+	// ContainmentMode_ExactPhrase indicates the comparison is between an exact
+	// phrase in the string and the constant.
+	ContainmentMode_ExactPhrase ContainmentMode = "ExactPhrase"
+
+	// ContainmentComparison_Exact indicates the comparison must be exact.
+	ContainmentComparison_Exact ContainmentComparison = "Exact"
+	// ContainmentComparison_IgnoreCase indicates the comparison ignores
+	// casing.
+	ContainmentComparison_IgnoreCase ContainmentComparison = "IgnoreCase"
+	// ContainmentComparison_IgnoreNonSpacingCharacters indicates the
+	// comparison ignores non-spacing characters.
+	ContainmentComparison_IgnoreNonSpacingCharacters ContainmentComparison = "IgnoreNonSpacingCharacters"
+	// ContainmentComparison_IgnoreCaseAndNonSpacingCharacters indicates the
+	// comparison ignores casing and non-spacing characters.
+	ContainmentComparison_IgnoreCaseAndNonSpacingCharacters ContainmentComparison = "IgnoreCaseAndNonSpacingCharacters"
+)
 
+// The Restriction element represents the restriction or query that is used
+// to filter items or folders in FindItem/FindFolder and search folder
+// operations.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/restriction
-type SearchExpression struct {
-	owner interface{}
-	Nodes []interface{}
+type Restriction struct {
+	SearchExpression SearchExpression
 }
 
-func Expr() *SearchExpression {
-	expr := new(SearchExpression)
-	initExpr(expr, nil, nil)
-	return expr
+// NewRestriction returns a Restriction with the provided SearchExpression.
+func NewRestriction(expr SearchExpression) *Restriction {
+	return &Restriction{SearchExpression: expr}
 }
 
-func initExpr(x *SearchExpression, owner interface{}, nodes nodes) {
-	x.owner = owner
-	if len(nodes) == 0 {
-		x.Nodes = make([]interface{}, 0, 2)
-	} else {
-		x.Nodes = nodes
-	}
+// SearchExpression is implemented by all search expressions which can be used
+// in a Restriction.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/searchexpression
+type SearchExpression interface {
+	searchExpression()
 }
 
-type andExpr struct {
-	XMLName xml.Name `xml:"And"`
-	SearchExpression
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/and
+type AndExpression struct {
+	XMLName     xml.Name `xml:"And"`
+	Expressions []SearchExpression
 }
 
-func newAndExpr(wrap nodes) *andExpr {
-	var expr andExpr
-	initExpr(&expr.SearchExpression, &expr, wrap)
-	return &expr
+// And returns a SearchExpression which matches when all expressions match.
+func And(expr ...SearchExpression) *AndExpression {
+	return &AndExpression{Expressions: expr}
 }
 
-type orExpr struct {
-	XMLName xml.Name `xml:"Or"`
-	SearchExpression
+func (*AndExpression) searchExpression() {}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/or
+type OrExpression struct {
+	XMLName     xml.Name `xml:"Or"`
+	Expressions []SearchExpression
 }
 
-func newOrExpr(wrap nodes) *orExpr {
-	var expr orExpr
-	initExpr(&expr.SearchExpression, &expr, wrap)
-	return &expr
+// Or returns a SearchExpression which matches when any of the expressions
+// match.
+func Or(expr ...SearchExpression) *OrExpression {
+	return &OrExpression{Expressions: expr}
 }
 
-func (expr *SearchExpression) Add(node interface{}) *SearchExpression {
-	switch len(expr.Nodes) {
-	case 0:
-		expr.Nodes = append(expr.Nodes, node)
-		return expr
+func (*OrExpression) searchExpression() {}
 
-	case 1:
-		switch n := expr.Nodes[0].(type) {
-		case andExpr:
-			// and vervangen bij leeg en wanneer node = Or
-			n.Add(node)
-		case orExpr:
-			// or vervangen bij leeg en wanneer node = And
-			n.Add(node)
-		default:
-			expr.Nodes = []interface{}{
-				newAndExpr(append(expr.Nodes, node)),
-			}
-		}
-	}
-	return expr
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/not
+type NotExpression struct {
+	XMLName    xml.Name `xml:"Not"`
+	Expression SearchExpression
 }
 
-type ConstantValue struct {
-	Value string `xml:",attr"`
+// Not returns a SearchExpression which negates expr.
+func Not(expr SearchExpression) *NotExpression {
+	return &NotExpression{Expression: expr}
 }
 
-type FieldURIOrConstant struct {
-	Constant *ConstantValue `xml:",omitempty"`
-	// IndexedFieldURI  *IndexedFieldURI  `xml:",omitempty"`
-	FieldURI *FieldURI `xml:",omitempty"`
-	// ExtendedFieldURI *ExtendedFieldURI `xml:",omitempty"`
+func (*NotExpression) searchExpression() {}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/exists
+type ExistsExpression struct {
+	XMLName  xml.Name `xml:"Exists"`
+	FieldURI FieldURI
 }
 
+// Exists returns a SearchExpression which matches when field exists.
+func Exists(field FieldUri) *ExistsExpression {
+	return &ExistsExpression{FieldURI: FieldURI{FieldURI: field}}
+}
+
+func (*ExistsExpression) searchExpression() {}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/contains
 type ContainsExpression struct {
 	XMLName xml.Name `xml:"Contains"`
 	// ContainmentMode identifies the boundaries of a search.
-	ContainmentMode string `xml:",attr"`
-	// ContainmentComparison determines whether the search ignores cases and spaces.
-	ContainmentComparison string `xml:",attr"`
+	ContainmentMode ContainmentMode `xml:",attr,omitempty"`
+	// ContainmentComparison determines whether the search ignores cases and
+	// spaces.
+	ContainmentComparison ContainmentComparison `xml:",attr,omitempty"`
 
 	FieldURI FieldURI
 	Constant ConstantValue
 }
 
-func (expr *SearchExpression) Contains(field FieldUri, val string) *SearchExpression {
-	return expr.Add(ContainsExpression{
-		ContainmentMode:       "Substring",
-		ContainmentComparison: "IgnoreCase",
+// Contains returns a SearchExpression which matches when field contains val,
+// ignoring casing.
+func Contains(field FieldUri, val string) *ContainsExpression {
+	return &ContainsExpression{
+		ContainmentMode:       ContainmentMode_Substring,
+		ContainmentComparison: ContainmentComparison_IgnoreCase,
 		FieldURI:              FieldURI{FieldURI: field},
 		Constant:              ConstantValue{Value: val},
-	})
+	}
 }
 
-type IsEqualTo struct {
-	XMLName xml.Name `xml:"IsEqualTo"`
+func (*ContainsExpression) searchExpression() {}
 
+// TwoOperandExpression compares the value of a property with a constant
+// value or the value of another property. It represents the IsEqualTo,
+// IsNotEqualTo, IsGreaterThan, IsGreaterThanOrEqualTo, IsLessThan and
+// IsLessThanOrEqualTo elements.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/isequalto
+type TwoOperandExpression struct {
+	XMLName            xml.Name
 	FieldURI           FieldURI
 	FieldURIOrConstant FieldURIOrConstant
 }
 
-func (expr *SearchExpression) Eq(field FieldUri, val interface{}) *SearchExpression {
-	eq := IsEqualTo{FieldURI: FieldURI{FieldURI: field}}
+func (*TwoOperandExpression) searchExpression() {}
+
+func newTwoOperandExpression(name string, field FieldUri, val interface{}) *TwoOperandExpression {
+	return &TwoOperandExpression{
+		XMLName:            xml.Name{Local: name},
+		FieldURI:           FieldURI{FieldURI: field},
+		FieldURIOrConstant: NewFieldURIOrConstant(val),
+	}
+}
+
+// IsEqualTo returns a SearchExpression which matches when the value of field
+// equals val. See NewFieldURIOrConstant for the supported types of val.
+func IsEqualTo(field FieldUri, val interface{}) *TwoOperandExpression {
+	return newTwoOperandExpression("IsEqualTo", field, val)
+}
+
+// IsNotEqualTo returns a SearchExpression which matches when the value of
+// field does not equal val.
+func IsNotEqualTo(field FieldUri, val interface{}) *TwoOperandExpression {
+	return newTwoOperandExpression("IsNotEqualTo", field, val)
+}
+
+// IsGreaterThan returns a SearchExpression which matches when the value of
+// field is greater than val.
+func IsGreaterThan(field FieldUri, val interface{}) *TwoOperandExpression {
+	return newTwoOperandExpression("IsGreaterThan", field, val)
+}
+
+// IsGreaterThanOrEqualTo returns a SearchExpression which matches when the
+// value of field is greater than or equal to val.
+func IsGreaterThanOrEqualTo(field FieldUri, val interface{}) *TwoOperandExpression {
+	return newTwoOperandExpression("IsGreaterThanOrEqualTo", field, val)
+}
+
+// IsLessThan returns a SearchExpression which matches when the value of
+// field is less than val.
+func IsLessThan(field FieldUri, val interface{}) *TwoOperandExpression {
+	return newTwoOperandExpression("IsLessThan", field, val)
+}
 
+// IsLessThanOrEqualTo returns a SearchExpression which matches when the value
+// of field is less than or equal to val.
+func IsLessThanOrEqualTo(field FieldUri, val interface{}) *TwoOperandExpression {
+	return newTwoOperandExpression("IsLessThanOrEqualTo", field, val)
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/constant
+type ConstantValue struct {
+	Value string `xml:",attr"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/fielduriorconstant
+type FieldURIOrConstant struct {
+	Constant *ConstantValue `xml:",omitempty"`
+	FieldURI *FieldURI      `xml:",omitempty"`
+}
+
+// NewFieldURIOrConstant returns a FieldURIOrConstant for val. A FieldUri or
+// FieldURI val results in a comparison with the value of that property, a
+// time.Time is formatted according to RFC3339 and any other value is
+// formatted using fmt.Sprint.
+func NewFieldURIOrConstant(val interface{}) FieldURIOrConstant {
 	switch v := val.(type) {
-	case string:
-		eq.FieldURIOrConstant.Constant = &ConstantValue{Value: v}
-	case *ConstantValue:
-		eq.FieldURIOrConstant.Constant = v
+	case FieldUri:
+		return FieldURIOrConstant{FieldURI: &FieldURI{FieldURI: v}}
+	case FieldURI:
+		return FieldURIOrConstant{FieldURI: &v}
+	case *FieldURI:
+		return FieldURIOrConstant{FieldURI: v}
 	case ConstantValue:
-		eq.FieldURIOrConstant.Constant = &v
+		return FieldURIOrConstant{Constant: &v}
+	case *ConstantValue:
+		return FieldURIOrConstant{Constant: v}
+	case time.Time:
+		return FieldURIOrConstant{Constant: &ConstantValue{Value: v.Format(time.RFC3339)}}
+	case string:
+		return FieldURIOrConstant{Constant: &ConstantValue{Value: v}}
+	default:
+		return FieldURIOrConstant{Constant: &ConstantValue{Value: fmt.Sprint(v)}}
 	}
-
-	return expr.Add(eq)
 }
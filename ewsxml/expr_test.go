@@ -0,0 +1,65 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestriction(t *testing.T) {
+	t.Run("single", func(t *testing.T) {
+		have, err := xml.Marshal(FindItem{
+			Traversal:   Traversal_Shallow,
+			ItemShape:   ItemShape{BaseShape: BaseShape_IdOnly},
+			Restriction: NewRestriction(IsEqualTo(FieldUri_Message_IsRead, false)),
+			ParentFolderIds: ParentFolderIds{
+				DistinguishedFolderId: DistinguishedFolderId{Id: DistinguishedFolderId_Inbox},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `<m:FindItem Traversal="Shallow"><m:ItemShape><BaseShape>IdOnly</BaseShape></m:ItemShape>`+
+			`<m:Restriction><IsEqualTo><FieldURI FieldURI="message:IsRead"></FieldURI>`+
+			`<FieldURIOrConstant><Constant Value="false"></Constant></FieldURIOrConstant></IsEqualTo></m:Restriction>`+
+			`<m:ParentFolderIds><DistinguishedFolderId Id="inbox"></DistinguishedFolderId></m:ParentFolderIds></m:FindItem>`,
+			string(have))
+	})
+	t.Run("nested", func(t *testing.T) {
+		since := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+		have, err := xml.Marshal(NewRestriction(And(
+			IsGreaterThan(FieldUri_Item_DateTimeReceived, since),
+			Or(
+				Contains(FieldUri_Item_Subject, "invoice"),
+				Not(Exists(FieldUri_Item_Categories)),
+			),
+		)))
+		assert.NoError(t, err)
+		assert.Equal(t, `<Restriction><And>`+
+			`<IsGreaterThan><FieldURI FieldURI="item:DateTimeReceived"></FieldURI>`+
+			`<FieldURIOrConstant><Constant Value="2023-01-02T03:04:05Z"></Constant></FieldURIOrConstant></IsGreaterThan>`+
+			`<Or><Contains ContainmentMode="Substring" ContainmentComparison="IgnoreCase">`+
+			`<FieldURI FieldURI="item:Subject"></FieldURI><Constant Value="invoice"></Constant></Contains>`+
+			`<Not><Exists><FieldURI FieldURI="item:Categories"></FieldURI></Exists></Not></Or>`+
+			`</And></Restriction>`, string(have))
+	})
+}
+
+func TestSortOrder(t *testing.T) {
+	have, err := xml.Marshal(FindItem{
+		Traversal:   Traversal_Shallow,
+		ItemShape:   ItemShape{BaseShape: BaseShape_IdOnly},
+		Restriction: NewRestriction(IsEqualTo(FieldUri_Message_IsRead, false)),
+		SortOrder:   NewSortOrder(Descending(FieldUri_Item_DateTimeReceived)),
+		ParentFolderIds: ParentFolderIds{
+			DistinguishedFolderId: DistinguishedFolderId{Id: DistinguishedFolderId_Inbox},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:FindItem Traversal="Shallow"><m:ItemShape><BaseShape>IdOnly</BaseShape></m:ItemShape>`+
+		`<m:Restriction><IsEqualTo><FieldURI FieldURI="message:IsRead"></FieldURI>`+
+		`<FieldURIOrConstant><Constant Value="false"></Constant></FieldURIOrConstant></IsEqualTo></m:Restriction>`+
+		`<m:SortOrder><FieldOrder Order="Descending"><FieldURI FieldURI="item:DateTimeReceived"></FieldURI></FieldOrder></m:SortOrder>`+
+		`<m:ParentFolderIds><DistinguishedFolderId Id="inbox"></DistinguishedFolderId></m:ParentFolderIds></m:FindItem>`,
+		string(have))
+}
@@ -0,0 +1,130 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"strconv"
+)
+
+// The PropertyType attribute represents the MAPI type of an extended
+// property.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/extendedfielduri
+type MapiPropertyType string
+
+func (s MapiPropertyType) String() string { return string(s) }
+
+// The DistinguishedPropertySetId attribute defines the well-known property
+// set of an extended property.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/extendedfielduri
+type DistinguishedPropertySetId string
+
+func (s DistinguishedPropertySetId) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	MapiPropertyType_ApplicationTime      MapiPropertyType = "ApplicationTime"
+	MapiPropertyType_ApplicationTimeArray MapiPropertyType = "ApplicationTimeArray"
+	MapiPropertyType_Binary               MapiPropertyType = "Binary"
+	MapiPropertyType_BinaryArray          MapiPropertyType = "BinaryArray"
+	MapiPropertyType_Boolean              MapiPropertyType = "Boolean"
+	MapiPropertyType_CLSID                MapiPropertyType = "CLSID"
+	MapiPropertyType_CLSIDArray           MapiPropertyType = "CLSIDArray"
+	MapiPropertyType_Currency             MapiPropertyType = "Currency"
+	MapiPropertyType_CurrencyArray        MapiPropertyType = "CurrencyArray"
+	MapiPropertyType_Double               MapiPropertyType = "Double"
+	MapiPropertyType_DoubleArray          MapiPropertyType = "DoubleArray"
+	MapiPropertyType_Error                MapiPropertyType = "Error"
+	MapiPropertyType_Float                MapiPropertyType = "Float"
+	MapiPropertyType_FloatArray           MapiPropertyType = "FloatArray"
+	MapiPropertyType_Integer              MapiPropertyType = "Integer"
+	MapiPropertyType_IntegerArray         MapiPropertyType = "IntegerArray"
+	MapiPropertyType_Long                 MapiPropertyType = "Long"
+	MapiPropertyType_LongArray            MapiPropertyType = "LongArray"
+	MapiPropertyType_Null                 MapiPropertyType = "Null"
+	MapiPropertyType_Object               MapiPropertyType = "Object"
+	MapiPropertyType_ObjectArray          MapiPropertyType = "ObjectArray"
+	MapiPropertyType_Short                MapiPropertyType = "Short"
+	MapiPropertyType_ShortArray           MapiPropertyType = "ShortArray"
+	MapiPropertyType_SystemTime           MapiPropertyType = "SystemTime"
+	MapiPropertyType_SystemTimeArray      MapiPropertyType = "SystemTimeArray"
+	MapiPropertyType_String               MapiPropertyType = "String"
+	MapiPropertyType_StringArray          MapiPropertyType = "StringArray"
+
+	DistinguishedPropertySetId_Meeting           DistinguishedPropertySetId = "Meeting"
+	DistinguishedPropertySetId_Appointment       DistinguishedPropertySetId = "Appointment"
+	DistinguishedPropertySetId_Common            DistinguishedPropertySetId = "Common"
+	DistinguishedPropertySetId_PublicStrings     DistinguishedPropertySetId = "PublicStrings"
+	DistinguishedPropertySetId_Address           DistinguishedPropertySetId = "Address"
+	DistinguishedPropertySetId_InternetHeaders   DistinguishedPropertySetId = "InternetHeaders"
+	DistinguishedPropertySetId_CalendarAssistant DistinguishedPropertySetId = "CalendarAssistant"
+	DistinguishedPropertySetId_UnifiedMessaging  DistinguishedPropertySetId = "UnifiedMessaging"
+	DistinguishedPropertySetId_Task              DistinguishedPropertySetId = "Task"
+	DistinguishedPropertySetId_Sharing           DistinguishedPropertySetId = "Sharing"
+
+	// PropertyTag_MessageFlags is the tag of the PR_MESSAGE_FLAGS property.
+	PropertyTag_MessageFlags = 0x0E07
+)
+
+// The ExtendedFieldURI element identifies an extended MAPI property. A
+// property is either identified by PropertyTag, or by a property set
+// (DistinguishedPropertySetId or PropertySetId) in combination with
+// PropertyName or PropertyId.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/extendedfielduri
+type ExtendedFieldURI struct {
+	XMLName                    xml.Name                   `xml:"ExtendedFieldURI"`
+	DistinguishedPropertySetId DistinguishedPropertySetId `xml:",attr,omitempty"`
+	PropertySetId              string                     `xml:",attr,omitempty"`
+	PropertyTag                string                     `xml:",attr,omitempty"`
+	PropertyName               string                     `xml:",attr,omitempty"`
+	PropertyId                 int                        `xml:",attr,omitempty"`
+	PropertyType               MapiPropertyType           `xml:",attr"`
+}
+
+// PropertyTag returns an ExtendedFieldURI which identifies the MAPI property
+// with the provided tag, e.g. PropertyTag_MessageFlags.
+func PropertyTag(tag uint16, typ MapiPropertyType) ExtendedFieldURI {
+	return ExtendedFieldURI{
+		PropertyTag:  "0x" + strconv.FormatUint(uint64(tag), 16),
+		PropertyType: typ,
+	}
+}
+
+// Equal indicates whether both ExtendedFieldURI identify the same property.
+func (uri ExtendedFieldURI) Equal(other ExtendedFieldURI) bool {
+	if uri.PropertyTag != "" || other.PropertyTag != "" {
+		a, errA := strconv.ParseUint(uri.PropertyTag, 0, 16)
+		b, errB := strconv.ParseUint(other.PropertyTag, 0, 16)
+		if errA != nil || errB != nil {
+			return uri.PropertyTag == other.PropertyTag && uri.PropertyType == other.PropertyType
+		}
+		return a == b && uri.PropertyType == other.PropertyType
+	}
+
+	return uri.DistinguishedPropertySetId == other.DistinguishedPropertySetId &&
+		uri.PropertySetId == other.PropertySetId &&
+		uri.PropertyName == other.PropertyName &&
+		uri.PropertyId == other.PropertyId &&
+		uri.PropertyType == other.PropertyType
+}
+
+// The ExtendedProperty element identifies extended MAPI properties on
+// folders and items. Value is used for single valued property types, Values
+// for the array property types.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/extendedproperty
+type ExtendedProperty struct {
+	ExtendedFieldURI ExtendedFieldURI
+	Value            string    `xml:",omitempty"`
+	Values           *[]string `xml:"Values>Value,omitempty"`
+}
+
+// ExtendedProperties is a list of ExtendedProperty elements.
+type ExtendedProperties []ExtendedProperty
+
+// Get returns the ExtendedProperty identified by uri.
+func (ep ExtendedProperties) Get(uri ExtendedFieldURI) (ExtendedProperty, bool) {
+	for _, p := range ep {
+		if p.ExtendedFieldURI.Equal(uri) {
+			return p, true
+		}
+	}
+	return ExtendedProperty{}, false
+}
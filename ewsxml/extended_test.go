@@ -0,0 +1,81 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendedProperty(t *testing.T) {
+	flags := PropertyTag(PropertyTag_MessageFlags, MapiPropertyType_Integer)
+
+	t.Run("item shape", func(t *testing.T) {
+		shape := ItemShape{BaseShape: BaseShape_IdOnly}
+		shape.WithExtendedFieldURI(flags, ExtendedFieldURI{
+			DistinguishedPropertySetId: DistinguishedPropertySetId_PublicStrings,
+			PropertyName:               "Keywords",
+			PropertyType:               MapiPropertyType_StringArray,
+		})
+
+		x, err := xml.MarshalIndent(shape, "", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, `<m:ItemShape>
+  <BaseShape>IdOnly</BaseShape>
+  <AdditionalProperties>
+    <ExtendedFieldURI PropertyTag="0xe07" PropertyType="Integer"></ExtendedFieldURI>
+    <ExtendedFieldURI DistinguishedPropertySetId="PublicStrings" PropertyName="Keywords" PropertyType="StringArray"></ExtendedFieldURI>
+  </AdditionalProperties>
+</m:ItemShape>`, string(x))
+	})
+	t.Run("set item field", func(t *testing.T) {
+		var u Updates
+		u.SetMessageExtendedProperty(ExtendedProperty{ExtendedFieldURI: flags, Value: "1"}).
+			DeleteExtendedProperty(flags)
+
+		x, err := xml.MarshalIndent(u, "", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, `<Updates>
+  <SetItemField>
+    <ExtendedFieldURI PropertyTag="0xe07" PropertyType="Integer"></ExtendedFieldURI>
+    <Message>
+      <ExtendedProperty>
+        <ExtendedFieldURI PropertyTag="0xe07" PropertyType="Integer"></ExtendedFieldURI>
+        <Value>1</Value>
+      </ExtendedProperty>
+    </Message>
+  </SetItemField>
+  <DeleteItemField>
+    <ExtendedFieldURI PropertyTag="0xe07" PropertyType="Integer"></ExtendedFieldURI>
+  </DeleteItemField>
+</Updates>`, string(x))
+	})
+	t.Run("response", func(t *testing.T) {
+		const data = `<t:Message xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:ItemId Id="AAAlAF" ChangeKey="CQAAAB" />
+  <t:ExtendedProperty>
+    <t:ExtendedFieldURI PropertyTag="0x0E07" PropertyType="Integer" />
+    <t:Value>9</t:Value>
+  </t:ExtendedProperty>
+  <t:ExtendedProperty>
+    <t:ExtendedFieldURI DistinguishedPropertySetId="PublicStrings" PropertyName="Keywords" PropertyType="StringArray" />
+    <t:Values><t:Value>foo</t:Value><t:Value>bar</t:Value></t:Values>
+  </t:ExtendedProperty>
+</t:Message>`
+
+		var msg Message
+		assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+
+		p, ok := msg.ExtendedProperty.Get(flags)
+		assert.True(t, ok)
+		assert.Equal(t, "9", p.Value)
+
+		p, ok = msg.ExtendedProperty.Get(ExtendedFieldURI{
+			DistinguishedPropertySetId: DistinguishedPropertySetId_PublicStrings,
+			PropertyName:               "Keywords",
+			PropertyType:               MapiPropertyType_StringArray,
+		})
+		assert.True(t, ok)
+		assert.Equal(t, &[]string{"foo", "bar"}, p.Values)
+	})
+}
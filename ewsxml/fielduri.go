@@ -2,10 +2,17 @@ package ewsxml
 
 import (
 	"encoding/xml"
+
+	"github.com/go-pogo/errors"
 )
 
+// FieldUri identifies a property of a folder, item, conversation or persona.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/fielduri
 type FieldUri string
 
+func (f FieldUri) String() string { return string(f) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
 const (
 	// FieldUri_Folder_FolderId identifies the FolderId property.
 	FieldUri_Folder_FolderId FieldUri = "folder:FolderId"
@@ -681,6 +688,364 @@ const (
 	FieldUri_Persona_Bodies FieldUri = "persona:Bodies"
 )
 
+var fieldUris = map[FieldUri]struct{}{
+	FieldUri_Folder_FolderId:                        {},
+	FieldUri_Folder_ParentFolderId:                  {},
+	FieldUri_Folder_DisplayName:                     {},
+	FieldUri_Folder_UnreadCount:                     {},
+	FieldUri_Folder_TotalCount:                      {},
+	FieldUri_Folder_ChildFolderCount:                {},
+	FieldUri_Folder_FolderClass:                     {},
+	FieldUri_Folder_SearchParameters:                {},
+	FieldUri_Folder_ManagedFolderInformation:        {},
+	FieldUri_Folder_PermissionSet:                   {},
+	FieldUri_Folder_EffectiveRights:                 {},
+	FieldUri_Folder_SharingEffectiveRights:          {},
+	FieldUri_Item_ItemId:                            {},
+	FieldUri_Item_ParentFolderId:                    {},
+	FieldUri_Item_ItemClass:                         {},
+	FieldUri_Item_MimeContent:                       {},
+	FieldUri_Item_Attachments:                       {},
+	FieldUri_Item_Subject:                           {},
+	FieldUri_Item_DateTimeReceived:                  {},
+	FieldUri_Item_Size:                              {},
+	FieldUri_Item_Categories:                        {},
+	FieldUri_Item_HasAttachments:                    {},
+	FieldUri_Item_Importance:                        {},
+	FieldUri_Item_InReplyTo:                         {},
+	FieldUri_Item_InternetMessageHeaders:            {},
+	FieldUri_Item_IsAssociated:                      {},
+	FieldUri_Item_IsDraft:                           {},
+	FieldUri_Item_IsFromMe:                          {},
+	FieldUri_Item_IsResend:                          {},
+	FieldUri_Item_IsSubmitted:                       {},
+	FieldUri_Item_IsUnmodified:                      {},
+	FieldUri_Item_DateTimeSent:                      {},
+	FieldUri_Item_DateTimeCreated:                   {},
+	FieldUri_Item_Body:                              {},
+	FieldUri_Item_ResponseObjects:                   {},
+	FieldUri_Item_Sensitivity:                       {},
+	FieldUri_Item_ReminderDueBy:                     {},
+	FieldUri_Item_ReminderIsSet:                     {},
+	FieldUri_Item_ReminderNextTime:                  {},
+	FieldUri_Item_ReminderMinutesBeforeStart:        {},
+	FieldUri_Item_DisplayTo:                         {},
+	FieldUri_Item_DisplayCc:                         {},
+	FieldUri_Item_Culture:                           {},
+	FieldUri_Item_EffectiveRights:                   {},
+	FieldUri_Item_LastModifiedName:                  {},
+	FieldUri_Item_LastModifiedTime:                  {},
+	FieldUri_Item_ConversationId:                    {},
+	FieldUri_Item_UniqueBody:                        {},
+	FieldUri_Item_Flag:                              {},
+	FieldUri_Item_StoreEntryId:                      {},
+	FieldUri_Item_InstanceKey:                       {},
+	FieldUri_Item_NormalizedBody:                    {},
+	FieldUri_Item_EntityExtractionResult:            {},
+	FieldUri_itemPolicyTag:                          {},
+	FieldUri_Item_ArchiveTag:                        {},
+	FieldUri_Item_RetentionDate:                     {},
+	FieldUri_Item_Preview:                           {},
+	FieldUri_Item_NextPredictedAction:               {},
+	FieldUri_Item_GroupingAction:                    {},
+	FieldUri_Item_PredictedActionReasons:            {},
+	FieldUri_Item_RightsManagementLicenseData:       {},
+	FieldUri_Item_BlockStatus:                       {},
+	FieldUri_Item_HasBlockedImages:                  {},
+	FieldUri_Item_WebClientReadFormQueryString:      {},
+	FieldUri_Item_WebClientEditFormQueryString:      {},
+	FieldUri_Item_TextBody:                          {},
+	FieldUri_Item_IconIndex:                         {},
+	FieldUri_Item_MimeContentUTF8:                   {},
+	FieldUri_Message_ConversationIndex:              {},
+	FieldUri_Message_ConversationTopic:              {},
+	FieldUri_Message_InternetMessageId:              {},
+	FieldUri_Message_IsRead:                         {},
+	FieldUri_Message_IsResponseRequested:            {},
+	FieldUri_Message_IsReadReceiptRequested:         {},
+	FieldUri_Message_IsDeliveryReceiptRequested:     {},
+	FieldUri_Message_ReceivedBy:                     {},
+	FieldUri_Message_ReceivedRepresenting:           {},
+	FieldUri_Message_References:                     {},
+	FieldUri_Message_ReplyTo:                        {},
+	FieldUri_Message_From:                           {},
+	FieldUri_Message_Sender:                         {},
+	FieldUri_Message_ToRecipients:                   {},
+	FieldUri_Message_CcRecipients:                   {},
+	FieldUri_Message_BccRecipients:                  {},
+	FieldUri_Message_ApprovalRequestData:            {},
+	FieldUri_Message_VotingInformation:              {},
+	FieldUri_Message_ReminderMessageData:            {},
+	FieldUri_Meeting_AssociatedCalendarItemId:       {},
+	FieldUri_Meeting_IsDelegated:                    {},
+	FieldUri_Meeting_IsOutOfDate:                    {},
+	FieldUri_Meeting_HasBeenProcessed:               {},
+	FieldUri_Meeting_ResponseType:                   {},
+	FieldUri_Meeting_ProposedStart:                  {},
+	FieldUri_Meeting_ProposedEnd:                    {},
+	FieldUri_MeetingRequest_MeetingRequestType:      {},
+	FieldUri_MeetingRequest_IntendedFreeBusyStatus:  {},
+	FieldUri_MeetingRequest_ChangeHighlights:        {},
+	FieldUri_Calendar_Start:                         {},
+	FieldUri_Calendar_End:                           {},
+	FieldUri_Calendar_OriginalStart:                 {},
+	FieldUri_Calendar_StartWallClock:                {},
+	FieldUri_Calendar_EndWallClock:                  {},
+	FieldUri_Calendar_StartTimeZoneId:               {},
+	FieldUri_Calendar_EndTimeZoneId:                 {},
+	FieldUri_Calendar_IsAllDayEvent:                 {},
+	FieldUri_Calendar_LegacyFreeBusyStatus:          {},
+	FieldUri_Calendar_Location:                      {},
+	FieldUri_Calendar_When:                          {},
+	FieldUri_Calendar_IsMeeting:                     {},
+	FieldUri_Calendar_IsCancelled:                   {},
+	FieldUri_Calendar_IsRecurring:                   {},
+	FieldUri_Calendar_MeetingRequestWasSent:         {},
+	FieldUri_Calendar_IsResponseRequested:           {},
+	FieldUri_Calendar_CalendarItemType:              {},
+	FieldUri_Calendar_MyResponseType:                {},
+	FieldUri_Calendar_Organizer:                     {},
+	FieldUri_Calendar_RequiredAttendees:             {},
+	FieldUri_Calendar_OptionalAttendees:             {},
+	FieldUri_Calendar_Resources:                     {},
+	FieldUri_Calendar_ConflictingMeetingCount:       {},
+	FieldUri_Calendar_AdjacentMeetingCount:          {},
+	FieldUri_Calendar_ConflictingMeetings:           {},
+	FieldUri_Calendar_AdjacentMeetings:              {},
+	FieldUri_Calendar_Duration:                      {},
+	FieldUri_Calendar_TimeZone:                      {},
+	FieldUri_Calendar_AppointmentReplyTime:          {},
+	FieldUri_Calendar_AppointmentSequenceNumber:     {},
+	FieldUri_Calendar_AppointmentState:              {},
+	FieldUri_Calendar_Recurrence:                    {},
+	FieldUri_Calendar_FirstOccurrence:               {},
+	FieldUri_Calendar_LastOccurrence:                {},
+	FieldUri_Calendar_ModifiedOccurrences:           {},
+	FieldUri_Calendar_DeletedOccurrences:            {},
+	FieldUri_Calendar_MeetingTimeZone:               {},
+	FieldUri_Calendar_ConferenceType:                {},
+	FieldUri_Calendar_AllowNewTimeProposal:          {},
+	FieldUri_Calendar_IsOnlineMeeting:               {},
+	FieldUri_Calendar_MeetingWorkspaceUrl:           {},
+	FieldUri_Calendar_NetShowUrl:                    {},
+	FieldUri_Calendar_UID:                           {},
+	FieldUri_Calendar_RecurrenceId:                  {},
+	FieldUri_Calendar_DateTimeStamp:                 {},
+	FieldUri_Calendar_StartTimeZone:                 {},
+	FieldUri_Calendar_EndTimeZone:                   {},
+	FieldUri_Calendar_JoinOnlineMeetingUrl:          {},
+	FieldUri_Calendar_OnlineMeetingSettings:         {},
+	FieldUri_Calendar_IsOrganizer:                   {},
+	FieldUri_Task_ActualWork:                        {},
+	FieldUri_Task_AssignedTime:                      {},
+	FieldUri_Task_BillingInformation:                {},
+	FieldUri_Task_ChangeCount:                       {},
+	FieldUri_Task_Companies:                         {},
+	FieldUri_Task_CompleteDate:                      {},
+	FieldUri_Task_Contacts:                          {},
+	FieldUri_Task_DelegationState:                   {},
+	FieldUri_Task_Delegator:                         {},
+	FieldUri_Task_DueDate:                           {},
+	FieldUri_Task_IsAssignmentEditable:              {},
+	FieldUri_Task_IsComplete:                        {},
+	FieldUri_Task_IsRecurring:                       {},
+	FieldUri_Task_IsTeamTask:                        {},
+	FieldUri_Task_Mileage:                           {},
+	FieldUri_Task_Owner:                             {},
+	FieldUri_Task_PercentComplete:                   {},
+	FieldUri_Task_Recurrence:                        {},
+	FieldUri_Task_StartDate:                         {},
+	FieldUri_Task_Status:                            {},
+	FieldUri_Task_StatusDescription:                 {},
+	FieldUri_Task_TotalWork:                         {},
+	FieldUri_Contacts_Alias:                         {},
+	FieldUri_Contacts_AssistantName:                 {},
+	FieldUri_Contacts_Birthday:                      {},
+	FieldUri_Contacts_BusinessHomePage:              {},
+	FieldUri_Contacts_Children:                      {},
+	FieldUri_Contacts_Companies:                     {},
+	FieldUri_Contacts_CompanyName:                   {},
+	FieldUri_Contacts_CompleteName:                  {},
+	FieldUri_Contacts_ContactSource:                 {},
+	FieldUri_Contacts_Culture:                       {},
+	FieldUri_Contacts_Department:                    {},
+	FieldUri_Contacts_DisplayName:                   {},
+	FieldUri_Contacts_DirectoryId:                   {},
+	FieldUri_Contacts_DirectReports:                 {},
+	FieldUri_Contacts_EmailAddresses:                {},
+	FieldUri_Contacts_FileAs:                        {},
+	FieldUri_Contacts_FileAsMapping:                 {},
+	FieldUri_Contacts_Generation:                    {},
+	FieldUri_Contacts_GivenName:                     {},
+	FieldUri_Contacts_ImAddresses:                   {},
+	FieldUri_Contacts_Initials:                      {},
+	FieldUri_Contacts_JobTitle:                      {},
+	FieldUri_Contacts_Manager:                       {},
+	FieldUri_Contacts_ManagerMailbox:                {},
+	FieldUri_Contacts_MiddleName:                    {},
+	FieldUri_Contacts_Mileage:                       {},
+	FieldUri_Contacts_MSExchangeCertificate:         {},
+	FieldUri_Contacts_Nickname:                      {},
+	FieldUri_Contacts_Notes:                         {},
+	FieldUri_Contacts_OfficeLocation:                {},
+	FieldUri_Contacts_PhoneNumbers:                  {},
+	FieldUri_Contacts_PhoneticFullName:              {},
+	FieldUri_Contacts_PhoneticFirstName:             {},
+	FieldUri_Contacts_PhoneticLastName:              {},
+	FieldUri_Contacts_Photo:                         {},
+	FieldUri_Contacts_PhysicalAddresses:             {},
+	FieldUri_Contacts_PostalAddressIndex:            {},
+	FieldUri_Contacts_Profession:                    {},
+	FieldUri_Contacts_SpouseName:                    {},
+	FieldUri_Contacts_Surname:                       {},
+	FieldUri_Contacts_WeddingAnniversary:            {},
+	FieldUri_Contacts_UserSMIMECertificate:          {},
+	FieldUri_Contacts_HasPicture:                    {},
+	FieldUri_DistributionList_Members:               {},
+	FieldUri_PostItem_PostedTime:                    {},
+	FieldUri_Conversation_ConversationId:            {},
+	FieldUri_Conversation_ConversationTopic:         {},
+	FieldUri_Conversation_UniqueRecipients:          {},
+	FieldUri_Conversation_GlobalUniqueRecipients:    {},
+	FieldUri_Conversation_UniqueUnreadSenders:       {},
+	FieldUri_Conversation_GlobalUniqueUnreadSenders: {},
+	FieldUri_Conversation_UniqueSenders:             {},
+	FieldUri_Conversation_GlobalUniqueSenders:       {},
+	FieldUri_Conversation_LastDeliveryTime:          {},
+	FieldUri_Conversation_GlobalLastDeliveryTime:    {},
+	FieldUri_Conversation_Categories:                {},
+	FieldUri_Conversation_GlobalCategories:          {},
+	FieldUri_Conversation_FlagStatus:                {},
+	FieldUri_Conversation_GlobalFlagStatus:          {},
+	FieldUri_Conversation_HasAttachments:            {},
+	FieldUri_Conversation_GlobalHasAttachments:      {},
+	FieldUri_Conversation_HasIrm:                    {},
+	FieldUri_Conversation_GlobalHasIrm:              {},
+	FieldUri_Conversation_MessageCount:              {},
+	FieldUri_Conversation_GlobalMessageCount:        {},
+	FieldUri_Conversation_UnreadCount:               {},
+	FieldUri_Conversation_GlobalUnreadCount:         {},
+	FieldUri_Conversation_Size:                      {},
+	FieldUri_Conversation_GlobalSize:                {},
+	FieldUri_Conversation_ItemClasses:               {},
+	FieldUri_Conversation_GlobalItemClasses:         {},
+	FieldUri_Conversation_Importance:                {},
+	FieldUri_Conversation_GlobalImportance:          {},
+	FieldUri_Conversation_ItemIds:                   {},
+	FieldUri_Conversation_GlobalItemIds:             {},
+	FieldUri_Conversation_LastModifiedTime:          {},
+	FieldUri_Conversation_InstanceKey:               {},
+	FieldUri_Conversation_Preview:                   {},
+	FieldUri_Conversation_GlobalParentFolderId:      {},
+	FieldUri_Conversation_NextPredictedAction:       {},
+	FieldUri_Conversation_GroupingAction:            {},
+	FieldUri_Conversation_IconIndex:                 {},
+	FieldUri_Conversation_GlobalIconIndex:           {},
+	FieldUri_Conversation_DraftItemIds:              {},
+	FieldUri_Persona_PersonaId:                      {},
+	FieldUri_Persona_PersonaType:                    {},
+	FieldUri_Persona_GivenName:                      {},
+	FieldUri_Persona_CompanyName:                    {},
+	FieldUri_Persona_Surname:                        {},
+	FieldUri_Persona_DisplayName:                    {},
+	FieldUri_Persona_EmailAddress:                   {},
+	FieldUri_Persona_FileAs:                         {},
+	FieldUri_Persona_HomeCity:                       {},
+	FieldUri_Persona_CreationTime:                   {},
+	FieldUri_Persona_RelevanceScore:                 {},
+	FieldUri_Persona_WorkCity:                       {},
+	FieldUri_Persona_PersonaObjectStatus:            {},
+	FieldUri_Persona_FileAsId:                       {},
+	FieldUri_Persona_DisplayNamePrefix:              {},
+	FieldUri_Persona_YomiCompanyName:                {},
+	FieldUri_Persona_YomiFirstName:                  {},
+	FieldUri_Persona_YomiLastName:                   {},
+	FieldUri_Persona_Title:                          {},
+	FieldUri_Persona_EmailAddresses:                 {},
+	FieldUri_Persona_PhoneNumber:                    {},
+	FieldUri_Persona_ImAddress:                      {},
+	FieldUri_Persona_ImAddresses:                    {},
+	FieldUri_Persona_ImAddresses2:                   {},
+	FieldUri_Persona_ImAddresses3:                   {},
+	FieldUri_Persona_FolderIds:                      {},
+	FieldUri_Persona_Attributions:                   {},
+	FieldUri_Persona_DisplayNames:                   {},
+	FieldUri_Persona_Initials:                       {},
+	FieldUri_Persona_FileAses:                       {},
+	FieldUri_Persona_FileAsIds:                      {},
+	FieldUri_Persona_DisplayNamePrefixes:            {},
+	FieldUri_Persona_GivenNames:                     {},
+	FieldUri_Persona_MiddleNames:                    {},
+	FieldUri_Persona_Surnames:                       {},
+	FieldUri_Persona_Generations:                    {},
+	FieldUri_Persona_Nicknames:                      {},
+	FieldUri_Persona_YomiCompanyNames:               {},
+	FieldUri_Persona_YomiFirstNames:                 {},
+	FieldUri_Persona_YomiLastNames:                  {},
+	FieldUri_Persona_BusinessPhoneNumbers:           {},
+	FieldUri_Persona_BusinessPhoneNumbers2:          {},
+	FieldUri_Persona_HomePhones:                     {},
+	FieldUri_Persona_HomePhones2:                    {},
+	FieldUri_Persona_MobilePhones:                   {},
+	FieldUri_Persona_MobilePhones2:                  {},
+	FieldUri_Persona_AssistantPhoneNumbers:          {},
+	FieldUri_Persona_CallbackPhones:                 {},
+	FieldUri_Persona_CarPhones:                      {},
+	FieldUri_Persona_HomeFaxes:                      {},
+	FieldUri_Persona_OrganizationMainPhones:         {},
+	FieldUri_Persona_OtherFaxes:                     {},
+	FieldUri_Persona_OtherTelephones:                {},
+	FieldUri_Persona_OtherPhones2:                   {},
+	FieldUri_Persona_Pagers:                         {},
+	FieldUri_Persona_RadioPhones:                    {},
+	FieldUri_Persona_TelexNumbers:                   {},
+	FieldUri_Persona_WorkFaxes:                      {},
+	FieldUri_Persona_Emails1:                        {},
+	FieldUri_Persona_Emails2:                        {},
+	FieldUri_Persona_Emails3:                        {},
+	FieldUri_Persona_BusinessHomePages:              {},
+	FieldUri_Persona_School:                         {},
+	FieldUri_Persona_PersonalHomePages:              {},
+	FieldUri_Persona_OfficeLocations:                {},
+	FieldUri_Persona_BusinessAddresses:              {},
+	FieldUri_Persona_HomeAddresses:                  {},
+	FieldUri_Persona_OtherAddresses:                 {},
+	FieldUri_Persona_Titles:                         {},
+	FieldUri_Persona_Departments:                    {},
+	FieldUri_Persona_CompanyNames:                   {},
+	FieldUri_Persona_Managers:                       {},
+	FieldUri_Persona_AssistantNames:                 {},
+	FieldUri_Persona_Professions:                    {},
+	FieldUri_Persona_SpouseNames:                    {},
+	FieldUri_Persona_Hobbies:                        {},
+	FieldUri_Persona_WeddingAnniversaries:           {},
+	FieldUri_Persona_Birthdays:                      {},
+	FieldUri_Persona_Children:                       {},
+	FieldUri_Persona_Locations:                      {},
+	FieldUri_Persona_ExtendedProperties:             {},
+	FieldUri_Persona_PostalAddress:                  {},
+	FieldUri_Persona_Bodies:                         {},
+}
+
+const ErrUnknownFieldUri errors.Msg = "unknown field uri"
+
+// Known indicates whether f is one of the FieldUri constants.
+func (f FieldUri) Known() bool {
+	_, ok := fieldUris[f]
+	return ok
+}
+
+// MarshalXMLAttr returns an ErrUnknownFieldUri error when f is not Known,
+// instead of sending it to the server.
+func (f FieldUri) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !f.Known() {
+		return xml.Attr{}, errors.Wrapf(ErrUnknownFieldUri, "%q", string(f))
+	}
+	return xml.Attr{Name: name, Value: string(f)}, nil
+}
+
+// The FieldURI element identifies frequently referenced properties by URI.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/fielduri
 type FieldURI struct {
 	XMLName  xml.Name `xml:"FieldURI"`
 	FieldURI FieldUri `xml:",attr"`
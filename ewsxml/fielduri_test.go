@@ -0,0 +1,19 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldUri_MarshalXMLAttr(t *testing.T) {
+	have, err := xml.Marshal(FieldURI{FieldURI: FieldUri_Message_IsRead})
+	assert.NoError(t, err)
+	assert.Equal(t, `<FieldURI FieldURI="message:IsRead"></FieldURI>`, string(have))
+
+	_, err = xml.Marshal(FieldURI{FieldURI: "item:Subjcet"})
+	assert.True(t, errors.Is(err, ErrUnknownFieldUri))
+	assert.False(t, FieldUri("item:Subjcet").Known())
+}
@@ -2,7 +2,6 @@ package ewsxml
 
 import (
 	"encoding/xml"
-	"time"
 )
 
 // Traversal defines whether the search finds items in folders or the folders'
@@ -22,6 +21,9 @@ func (s BaseShape) String() string { return string(s) }
 const (
 	// Traversal_Shallow returns only the identities of items in the folder.
 	Traversal_Shallow Traversal = "Shallow"
+	// Traversal_Deep returns all the folders in the hierarchy of the parent
+	// folder. It can only be used with FindFolder.
+	Traversal_Deep Traversal = "Deep"
 	// Traversal_SoftDeleted returns only the identities of items that are in a
 	// folder's dumpster. Note that a soft-deleted traversal combined with a
 	// search restriction will result in zero items returned even if there are
@@ -60,6 +62,68 @@ type FindItem struct {
 	XMLName   xml.Name  `xml:"m:FindItem"`
 	Traversal Traversal `xml:",attr"`
 	ItemShape ItemShape
+
+	// Only one of the views may be set.
+	IndexedPageItemView    *IndexedPageItemView    `xml:",omitempty"`
+	FractionalPageItemView *FractionalPageItemView `xml:",omitempty"`
+	CalendarView           *CalendarView           `xml:",omitempty"`
+
+	// Restriction filters the items which are returned.
+	Restriction     *Restriction `xml:"m:Restriction,omitempty"`
+	SortOrder       *SortOrder   `xml:"m:SortOrder,omitempty"`
+	ParentFolderIds ParentFolderIds
+}
+
+type BasePoint string
+
+func (s BasePoint) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	BasePoint_Beginning BasePoint = "Beginning"
+	BasePoint_End       BasePoint = "End"
+)
+
+// SortDirection describes the sort order of a FieldOrder.
+type SortDirection string
+
+func (s SortDirection) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	SortDirection_Ascending  SortDirection = "Ascending"
+	SortDirection_Descending SortDirection = "Descending"
+)
+
+// The SortOrder element defines how items are sorted in a FindItem or
+// FindPeople request. Items are sorted by the first FieldOrder, then by the
+// next and so on.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sortorder
+type SortOrder struct {
+	FieldOrder []FieldOrder
+}
+
+// NewSortOrder returns a SortOrder with the provided FieldOrder entries.
+func NewSortOrder(fo ...FieldOrder) *SortOrder {
+	return &SortOrder{FieldOrder: fo}
+}
+
+// The FieldOrder element represents a single field by which to sort results
+// and indicates the direction for the sort.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/fieldorder
+type FieldOrder struct {
+	Order    SortDirection `xml:",attr"`
+	FieldURI FieldURI
+}
+
+// Ascending returns a FieldOrder which sorts field in ascending order.
+func Ascending(field FieldUri) FieldOrder {
+	return FieldOrder{Order: SortDirection_Ascending, FieldURI: FieldURI{FieldURI: field}}
+}
+
+// Descending returns a FieldOrder which sorts field in descending order.
+func Descending(field FieldUri) FieldOrder {
+	return FieldOrder{Order: SortDirection_Descending, FieldURI: FieldURI{FieldURI: field}}
 }
 
 // The ItemShape element identifies a set of properties to return in a GetItem
@@ -72,22 +136,84 @@ type ItemShape struct {
 	BodyType           BodyType  `xml:",omitempty"`
 	FilterHtmlContent  bool      `xml:",omitempty"`
 	// ConvertHtmlCodePageToUTF8
-	// AdditionalProperties
+	AdditionalProperties *AdditionalProperties `xml:",omitempty"`
 }
 
-// type IndexedPageItemView struct {
-// 	XMLName xml.Name `xml:"IndexedPageItemView"`
-// }
+// WithFieldURI requests the properties identified by fu in addition to the
+// properties of BaseShape.
+func (s *ItemShape) WithFieldURI(fu ...FieldUri) *ItemShape {
+	if s.AdditionalProperties == nil {
+		s.AdditionalProperties = new(AdditionalProperties)
+	}
+	s.AdditionalProperties.WithFieldURI(fu...)
+	return s
+}
 
-// type FractionalPageItemView struct {
-// 	XMLName xml.Name `xml:"FractionalPageItemView"`
-// }
+// WithExtendedFieldURI requests the extended properties identified by uri in
+// addition to the properties of BaseShape.
+func (s *ItemShape) WithExtendedFieldURI(uri ...ExtendedFieldURI) *ItemShape {
+	if s.AdditionalProperties == nil {
+		s.AdditionalProperties = new(AdditionalProperties)
+	}
+	s.AdditionalProperties.WithExtendedFieldURI(uri...)
+	return s
+}
+
+// The AdditionalProperties element identifies additional properties for use
+// in GetItem, UpdateItem, CreateItem, FindItem, or FindFolder requests.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/additionalproperties
+type AdditionalProperties struct {
+	ExtendedFieldURI []ExtendedFieldURI
+	FieldURI         []FieldURI
+	// IndexedFieldURI  []IndexedFieldURI
+}
+
+func (ap *AdditionalProperties) WithFieldURI(fu ...FieldUri) *AdditionalProperties {
+	for _, x := range fu {
+		ap.FieldURI = append(ap.FieldURI, FieldURI{FieldURI: x})
+	}
+	return ap
+}
 
+// WithExtendedFieldURI requests the extended properties identified by uri.
+func (ap *AdditionalProperties) WithExtendedFieldURI(uri ...ExtendedFieldURI) *AdditionalProperties {
+	ap.ExtendedFieldURI = append(ap.ExtendedFieldURI, uri...)
+	return ap
+}
+
+// The IndexedPageItemView element describes how paged item information is
+// returned. Use RootFolder.IndexedPagingOffset of the response as Offset of
+// the next request to get the next page.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/indexedpageitemview
+type IndexedPageItemView struct {
+	XMLName            xml.Name  `xml:"m:IndexedPageItemView"`
+	MaxEntriesReturned int       `xml:",attr,omitempty"`
+	Offset             int       `xml:",attr"`
+	BasePoint          BasePoint `xml:",attr"`
+}
+
+// The FractionalPageItemView element describes where the paged view starts
+// and the maximum number of items returned, where the position is a fraction
+// of the total number of items. Use RootFolder.NumeratorOffset and
+// RootFolder.AbsoluteDenominator of the response to request the next page.
+// It cannot be combined with other views, like IndexedPageItemView.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/fractionalpageitemview
+type FractionalPageItemView struct {
+	XMLName            xml.Name `xml:"m:FractionalPageItemView"`
+	MaxEntriesReturned int      `xml:",attr,omitempty"`
+	Numerator          int      `xml:",attr"`
+	Denominator        int      `xml:",attr"`
+}
+
+// The CalendarView element defines a FindItem operation as returning calendar
+// items in a set as they appear in a calendar, with recurring calendar items
+// expanded into their occurrences between StartDate and EndDate.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/calendarview
 type CalendarView struct {
-	XMLName            xml.Name  `xml:"m:CalendarView"`
-	MaxEntriesReturned uint      `xml:",attr,omitempty"`
-	StartDate          time.Time `xml:",attr"`
-	EndDate            time.Time `xml:",attr"`
+	XMLName            xml.Name    `xml:"m:CalendarView"`
+	MaxEntriesReturned uint        `xml:",attr,omitempty"`
+	StartDate          EwsDateTime `xml:",attr"`
+	EndDate            EwsDateTime `xml:",attr"`
 }
 
 // type ContactsView struct {
@@ -103,6 +229,16 @@ type FindItemResponseMessage struct {
 	RootFolder         RootFolder
 }
 
+// Messages returns the messages found in the RootFolder.
+func (r *FindItemResponseMessage) Messages() []Message {
+	return r.RootFolder.Items.Message
+}
+
+// CalendarItems returns the calendar items found in the RootFolder.
+func (r *FindItemResponseMessage) CalendarItems() []CalendarItem {
+	return r.RootFolder.Items.CalendarItem
+}
+
 // The RootFolder element contains the results of a search of a single root
 // folder during a FindItem operation.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/rootfolder-finditemresponsemessage
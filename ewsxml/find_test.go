@@ -0,0 +1,61 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemShape_WithFieldURI(t *testing.T) {
+	shape := ItemShape{BaseShape: BaseShape_IdOnly}
+	shape.WithFieldURI(FieldUri_Item_DateTimeReceived, FieldUri_Message_From)
+
+	have, err := xml.Marshal(shape)
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:ItemShape><BaseShape>IdOnly</BaseShape><AdditionalProperties>`+
+		`<FieldURI FieldURI="item:DateTimeReceived"></FieldURI><FieldURI FieldURI="message:From"></FieldURI>`+
+		`</AdditionalProperties></m:ItemShape>`, string(have))
+}
+
+func TestCalendarView_MarshalXML(t *testing.T) {
+	loc := time.FixedZone("CEST", 2*60*60)
+	find := FindItem{CalendarView: &CalendarView{
+		MaxEntriesReturned: 5,
+		StartDate:          NewEwsDateTime(time.Date(2023, 6, 1, 9, 30, 0, 0, loc)),
+		EndDate:            NewEwsDateTime(time.Date(2023, 6, 2, 0, 0, 0, 500, time.UTC)),
+	}}
+	find.ParentFolderIds.DistinguishedFolderId.WithId(DistinguishedFolderId_Calendar)
+
+	have, err := xml.Marshal(find.CalendarView)
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CalendarView MaxEntriesReturned="5" StartDate="2023-06-01T07:30:00Z" EndDate="2023-06-02T00:00:00Z"></m:CalendarView>`, string(have))
+
+	have, err = xml.Marshal(find)
+	assert.NoError(t, err)
+	assert.Contains(t, string(have), `<m:CalendarView MaxEntriesReturned="5" StartDate="2023-06-01T07:30:00Z" EndDate="2023-06-02T00:00:00Z"></m:CalendarView>`)
+}
+
+func TestFindItemResponseMessage(t *testing.T) {
+	const data = `<m:FindItemResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:RootFolder TotalItemsInView="2" IncludesLastItemInRange="true">
+    <t:Items>
+      <t:Message><t:ItemId Id="a" /></t:Message>
+      <t:CalendarItem><t:ItemId Id="b" /></t:CalendarItem>
+    </t:Items>
+  </m:RootFolder>
+</m:FindItemResponseMessage>`
+
+	var msg FindItemResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	if assert.Len(t, msg.Messages(), 1) {
+		assert.Equal(t, "a", msg.Messages()[0].ItemId.Id)
+	}
+	if assert.Len(t, msg.CalendarItems(), 1) {
+		assert.Equal(t, "b", msg.CalendarItems()[0].ItemId.Id)
+	}
+}
@@ -2,6 +2,8 @@ package ewsxml
 
 import (
 	"encoding/xml"
+
+	"github.com/go-pogo/errors"
 )
 
 // DistinguishedFolderId_Id identifies a default folder.
@@ -10,6 +12,7 @@ type DistinguishedFolderId_Id string
 
 func (d DistinguishedFolderId_Id) String() string { return string(d) }
 
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
 const (
 	// DistinguishedFolderId_Calendar represents the Calendar folder.
 	DistinguishedFolderId_Calendar DistinguishedFolderId_Id = "calendar"
@@ -93,8 +96,80 @@ const (
 	DistinguishedFolderId_PeopleConnect DistinguishedFolderId_Id = "peopleconnect"
 	// DistinguishedFolderId_Favorites represents the Favorites folder.
 	DistinguishedFolderId_Favorites DistinguishedFolderId_Id = "favorites"
+	// DistinguishedFolderId_PublicFoldersRoot represents the root of the
+	// public folders.
+	DistinguishedFolderId_PublicFoldersRoot DistinguishedFolderId_Id = "publicfoldersroot"
+)
+
+var distinguishedFolderIds = map[DistinguishedFolderId_Id]struct{}{
+	DistinguishedFolderId_Calendar:                         {},
+	DistinguishedFolderId_Contacts:                         {},
+	DistinguishedFolderId_DeletedItems:                     {},
+	DistinguishedFolderId_Drafts:                           {},
+	DistinguishedFolderId_Inbox:                            {},
+	DistinguishedFolderId_Journal:                          {},
+	DistinguishedFolderId_Notes:                            {},
+	DistinguishedFolderId_Outbox:                           {},
+	DistinguishedFolderId_SentItems:                        {},
+	DistinguishedFolderId_Tasks:                            {},
+	DistinguishedFolderId_MsgFolderRoot:                    {},
+	DistinguishedFolderId_Root:                             {},
+	DistinguishedFolderId_JunkEmail:                        {},
+	DistinguishedFolderId_SearchFolders:                    {},
+	DistinguishedFolderId_Voicemail:                        {},
+	DistinguishedFolderId_RecoverableItemsRoot:             {},
+	DistinguishedFolderId_RecoverableItemsDeletions:        {},
+	DistinguishedFolderId_RecoverableItemsVersions:         {},
+	DistinguishedFolderId_RecoverableItemsPurges:           {},
+	DistinguishedFolderId_ArchiveRoot:                      {},
+	DistinguishedFolderId_ArchiveMsgFolderRoot:             {},
+	DistinguishedFolderId_ArchiveDeletedItems:              {},
+	DistinguishedFolderId_ArchiveInbox:                     {},
+	DistinguishedFolderId_ArchiveRecoverableItemsRoot:      {},
+	DistinguishedFolderId_ArchiveRecoverableItemsDeletions: {},
+	DistinguishedFolderId_ArchiveRecoverableItemsVersions:  {},
+	DistinguishedFolderId_ArchiveRecoverableItemsPurges:    {},
+	DistinguishedFolderId_SyncIssues:                       {},
+	DistinguishedFolderId_Conflicts:                        {},
+	DistinguishedFolderId_LocalFailures:                    {},
+	DistinguishedFolderId_ServerFailures:                   {},
+	DistinguishedFolderId_RecipientCache:                   {},
+	DistinguishedFolderId_QuickContacts:                    {},
+	DistinguishedFolderId_ConversationHistory:              {},
+	DistinguishedFolderId_AdminAuditLogs:                   {},
+	DistinguishedFolderId_TodoSearch:                       {},
+	DistinguishedFolderId_MyContacts:                       {},
+	DistinguishedFolderId_Directory:                        {},
+	DistinguishedFolderId_IMContactList:                    {},
+	DistinguishedFolderId_PeopleConnect:                    {},
+	DistinguishedFolderId_Favorites:                        {},
+	DistinguishedFolderId_PublicFoldersRoot:                {},
+}
+
+const (
+	ErrUnknownDistinguishedFolderId errors.Msg = "unknown distinguished folder id"
+	ErrMissingFolderId              errors.Msg = "either FolderId or DistinguishedFolderId must be set"
 )
 
+// Known indicates whether d is one of the DistinguishedFolderId_Id constants.
+func (d DistinguishedFolderId_Id) Known() bool {
+	_, ok := distinguishedFolderIds[d]
+	return ok
+}
+
+// MarshalXMLAttr returns an ErrMissingFolderId error when d is empty, or an
+// ErrUnknownDistinguishedFolderId error when d is not Known, instead of
+// sending it to the server.
+func (d DistinguishedFolderId_Id) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if d == "" {
+		return xml.Attr{}, errors.New(ErrMissingFolderId)
+	}
+	if !d.Known() {
+		return xml.Attr{}, errors.Wrapf(ErrUnknownDistinguishedFolderId, "%q", string(d))
+	}
+	return xml.Attr{Name: name, Value: string(d)}, nil
+}
+
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/parentfolderids
 type ParentFolderIds struct {
 	XMLName               xml.Name `xml:"m:ParentFolderIds"`
@@ -108,6 +183,11 @@ type FolderId struct {
 	ChangeKey string `xml:",attr,omitempty"`
 }
 
+// NewFolderId returns a FolderId with the given id and optional change key.
+func NewFolderId(id, changeKey string) *FolderId {
+	return &FolderId{Id: id, ChangeKey: changeKey}
+}
+
 // The DistinguishedFolderId element identifies folders that can be referenced
 // by name.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/distinguishedfolderid
@@ -117,16 +197,35 @@ type DistinguishedFolderId struct {
 	Mailbox   *Mailbox                 `xml:",omitempty"`
 }
 
+// NewDistinguishedFolderId returns a DistinguishedFolderId for the folder
+// with the given name.
+func NewDistinguishedFolderId(id DistinguishedFolderId_Id) *DistinguishedFolderId {
+	return &DistinguishedFolderId{Id: id}
+}
+
 func (d *DistinguishedFolderId) WithId(id DistinguishedFolderId_Id) *DistinguishedFolderId {
 	d.Id = id
 	return d
 }
 
+// WithMailbox targets the folder of another user's mailbox, for example a
+// delegate's calendar. The folder of the authenticated user is targeted when
+// mb is nil.
 func (d *DistinguishedFolderId) WithMailbox(mb *Mailbox) *DistinguishedFolderId {
 	d.Mailbox = mb
 	return d
 }
 
+// WithEmailAddress targets the folder of the mailbox with the provided
+// email address, see WithMailbox.
+func (d *DistinguishedFolderId) WithEmailAddress(email string) *DistinguishedFolderId {
+	if email == "" {
+		d.Mailbox = nil
+		return d
+	}
+	return d.WithMailbox(EmailMailbox(email))
+}
+
 // The ParentFolderId element represents the identifier of the parent folder
 // that contains the item or folder.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/parentfolderid
@@ -135,3 +234,73 @@ type ParentFolderId struct {
 	Id        string   `xml:",attr"`
 	ChangeKey string   `xml:",attr,omitempty"`
 }
+
+// The FolderShape element identifies the folder properties to include in a
+// GetFolder, FindFolder, or SyncFolderHierarchy response.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/foldershape
+type FolderShape struct {
+	XMLName              xml.Name              `xml:"m:FolderShape"`
+	BaseShape            BaseShape             `xml:",omitempty"`
+	AdditionalProperties *AdditionalProperties `xml:",omitempty"`
+}
+
+// The Folder element defines a folder to create, get, find, synchronize, or
+// update. It is also used for the CalendarFolder, ContactsFolder, SearchFolder
+// and TasksFolder elements.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/folder
+type Folder struct {
+	FolderId         *FolderId `xml:",omitempty"`
+	ParentFolderId   *FolderId `xml:",omitempty"`
+	FolderClass      string    `xml:",omitempty"`
+	DisplayName      string    `xml:",omitempty"`
+	TotalCount       int       `xml:",omitempty"`
+	ChildFolderCount int       `xml:",omitempty"`
+	UnreadCount      int       `xml:",omitempty"`
+}
+
+// The Folders element contains an array of folders.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/folders-ex15websvcsotherref
+type Folders struct {
+	Folder         []Folder `xml:",omitempty"`
+	CalendarFolder []Folder `xml:",omitempty"`
+	ContactsFolder []Folder `xml:",omitempty"`
+	SearchFolder   []Folder `xml:",omitempty"`
+	TasksFolder    []Folder `xml:",omitempty"`
+}
+
+// All returns all folders, regardless of their type.
+func (f *Folders) All() []Folder {
+	res := make([]Folder, 0, len(f.Folder)+len(f.CalendarFolder)+len(f.ContactsFolder)+len(f.SearchFolder)+len(f.TasksFolder))
+	res = append(res, f.Folder...)
+	res = append(res, f.CalendarFolder...)
+	res = append(res, f.ContactsFolder...)
+	res = append(res, f.SearchFolder...)
+	res = append(res, f.TasksFolder...)
+	return res
+}
+
+// FolderIds returns the FolderId of each folder which has one.
+func (f *Folders) FolderIds() []FolderId {
+	all := f.All()
+	res := make([]FolderId, 0, len(all))
+	for _, x := range all {
+		if x.FolderId != nil {
+			res = append(res, *x.FolderId)
+		}
+	}
+	return res
+}
+
+const ErrAmbiguousFolderId errors.Msg = "only one of FolderId and DistinguishedFolderId may be set"
+
+// validateFolderId returns an error unless exactly one of the two id forms
+// is set.
+func validateFolderId(f *FolderId, d *DistinguishedFolderId) error {
+	if f == nil && d == nil {
+		return errors.New(ErrMissingFolderId)
+	}
+	if f != nil && d != nil {
+		return errors.New(ErrAmbiguousFolderId)
+	}
+	return nil
+}
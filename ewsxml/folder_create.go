@@ -0,0 +1,25 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The CreateFolder element defines a request to create folders in the
+// Exchange store. Only one of FolderId and DistinguishedFolderId should be
+// set to identify the parent folder.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createfolder
+type CreateFolder struct {
+	XMLName               xml.Name               `xml:"m:CreateFolder"`
+	FolderId              *FolderId              `xml:"m:ParentFolderId>FolderId,omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:"m:ParentFolderId>DistinguishedFolderId,omitempty"`
+	Folders               Folders                `xml:"m:Folders"`
+}
+
+// The CreateFolderResponseMessage element contains the status and result of
+// a single CreateFolder operation request. The response contains a message
+// for each folder in the request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createfolderresponsemessage
+type CreateFolderResponseMessage struct {
+	ResponseMessage
+	Folders Folders
+}
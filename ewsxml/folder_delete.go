@@ -0,0 +1,25 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The DeleteFolder element defines a request to delete folders from a mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deletefolder
+type DeleteFolder struct {
+	XMLName               xml.Name                `xml:"m:DeleteFolder"`
+	DeleteType            DeleteType              `xml:",attr"`
+	FolderId              []FolderId              `xml:"m:FolderIds>FolderId,omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:"m:FolderIds>DistinguishedFolderId,omitempty"`
+}
+
+// The EmptyFolder element defines a request to empty folders in a mailbox.
+// When DeleteSubFolders is set the sub folders are deleted as well.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/emptyfolder
+type EmptyFolder struct {
+	XMLName               xml.Name                `xml:"m:EmptyFolder"`
+	DeleteType            DeleteType              `xml:",attr"`
+	DeleteSubFolders      bool                    `xml:",attr"`
+	FolderId              []FolderId              `xml:"m:FolderIds>FolderId,omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:"m:FolderIds>DistinguishedFolderId,omitempty"`
+}
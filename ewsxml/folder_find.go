@@ -0,0 +1,46 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The FindFolder element defines a request to find folders in a mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/findfolder
+type FindFolder struct {
+	XMLName               xml.Name  `xml:"m:FindFolder"`
+	Traversal             Traversal `xml:",attr"`
+	FolderShape           FolderShape
+	IndexedPageFolderView *IndexedPageFolderView  `xml:",omitempty"`
+	FolderId              []FolderId              `xml:"m:ParentFolderIds>FolderId,omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:"m:ParentFolderIds>DistinguishedFolderId,omitempty"`
+}
+
+// The IndexedPageFolderView element describes how paged folder information
+// is returned for a FindFolder request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/indexedpagefolderview
+type IndexedPageFolderView struct {
+	XMLName            xml.Name  `xml:"m:IndexedPageFolderView"`
+	MaxEntriesReturned int       `xml:",attr,omitempty"`
+	Offset             int       `xml:",attr"`
+	BasePoint          BasePoint `xml:",attr"`
+}
+
+// The FindFolderResponseMessage element contains the status and result of a
+// single FindFolder operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/findfolderresponsemessage
+type FindFolderResponseMessage struct {
+	ResponseMessage
+	RootFolder FindFolderRootFolder
+}
+
+// The FindFolderRootFolder element contains the results of a search of a
+// single root folder during a FindFolder operation.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/rootfolder-findfolderresponsemessage
+type FindFolderRootFolder struct {
+	IndexedPagingOffset     int  `xml:",attr"`
+	NumeratorOffset         int  `xml:",attr"`
+	AbsoluteDenominator     int  `xml:",attr"`
+	IncludesLastItemInRange bool `xml:",attr"`
+	TotalItemsInView        int  `xml:",attr"`
+	Folders                 Folders
+}
@@ -0,0 +1,23 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The GetFolder element defines a request to get folders from a mailbox in
+// the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getfolder
+type GetFolder struct {
+	XMLName               xml.Name `xml:"m:GetFolder"`
+	FolderShape           FolderShape
+	FolderId              []FolderId              `xml:"m:FolderIds>FolderId,omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:"m:FolderIds>DistinguishedFolderId,omitempty"`
+}
+
+// The GetFolderResponseMessage element contains the status and result of a
+// single GetFolder operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getfolderresponsemessage
+type GetFolderResponseMessage struct {
+	ResponseMessage
+	Folders Folders
+}
@@ -0,0 +1,43 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The MoveFolder element defines a request to move folders in the Exchange
+// store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/movefolder
+type MoveFolder struct {
+	XMLName               xml.Name `xml:"m:MoveFolder"`
+	ToFolderId            ToFolderId
+	FolderId              []FolderId              `xml:"m:FolderIds>FolderId,omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:"m:FolderIds>DistinguishedFolderId,omitempty"`
+}
+
+// The MoveFolderResponseMessage element contains the status and result of a
+// single MoveFolder operation request. A moved folder keeps its Id but may
+// get a new ChangeKey, which is returned in Folders.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/movefolderresponsemessage
+type MoveFolderResponseMessage struct {
+	ResponseMessage
+	Folders Folders
+}
+
+// The CopyFolder element defines a request to copy folders in the Exchange
+// store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyfolder
+type CopyFolder struct {
+	XMLName               xml.Name `xml:"m:CopyFolder"`
+	ToFolderId            ToFolderId
+	FolderId              []FolderId              `xml:"m:FolderIds>FolderId,omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:"m:FolderIds>DistinguishedFolderId,omitempty"`
+}
+
+// The CopyFolderResponseMessage element contains the status and result of a
+// single CopyFolder operation request. The copy is a new folder, its FolderId
+// is returned in Folders.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyfolderresponsemessage
+type CopyFolderResponseMessage struct {
+	ResponseMessage
+	Folders Folders
+}
@@ -0,0 +1,83 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistinguishedFolderId_WithEmailAddress(t *testing.T) {
+	var ids ParentFolderIds
+	ids.DistinguishedFolderId.WithId("calendar")
+
+	have, err := xml.Marshal(ids)
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:ParentFolderIds><DistinguishedFolderId Id="calendar"></DistinguishedFolderId></m:ParentFolderIds>`, string(have))
+
+	ids.DistinguishedFolderId.WithEmailAddress("delegator@example.com")
+	have, err = xml.Marshal(ids)
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:ParentFolderIds><DistinguishedFolderId Id="calendar">`+
+		`<Mailbox><EmailAddress>delegator@example.com</EmailAddress></Mailbox>`+
+		`</DistinguishedFolderId></m:ParentFolderIds>`, string(have))
+}
+
+func TestMarkAllItemsAsRead(t *testing.T) {
+	have, err := xml.MarshalIndent(MarkAllItemsAsRead{
+		ReadFlag:              true,
+		SuppressReadReceipts:  true,
+		DistinguishedFolderId: []DistinguishedFolderId{{Id: DistinguishedFolderId_Inbox}},
+	}, "", "  ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:MarkAllItemsAsRead>
+  <m:ReadFlag>true</m:ReadFlag>
+  <m:SuppressReadReceipts>true</m:SuppressReadReceipts>
+  <m:FolderIds>
+    <DistinguishedFolderId Id="inbox"></DistinguishedFolderId>
+  </m:FolderIds>
+</m:MarkAllItemsAsRead>`, string(have))
+}
+
+func TestDistinguishedFolderId_Id_MarshalXMLAttr(t *testing.T) {
+	have, err := xml.Marshal(new(DistinguishedFolderId).WithId(DistinguishedFolderId_PublicFoldersRoot))
+	assert.NoError(t, err)
+	assert.Equal(t, `<DistinguishedFolderId Id="publicfoldersroot"></DistinguishedFolderId>`, string(have))
+
+	_, err = xml.Marshal(new(DistinguishedFolderId).WithId("sent"))
+	assert.True(t, errors.Is(err, ErrUnknownDistinguishedFolderId))
+	assert.False(t, DistinguishedFolderId_Id("sent").Known())
+
+	_, err = xml.Marshal(ParentFolderIds{})
+	assert.True(t, errors.Is(err, ErrMissingFolderId))
+}
+
+func TestFolderById(t *testing.T) {
+	have, err := xml.Marshal(CreateItem{SavedItemFolderId: FolderById("AAMkAF", "AQAAAB")})
+	assert.NoError(t, err)
+	assert.Contains(t, string(have), `<m:SavedItemFolderId><FolderId Id="AAMkAF" ChangeKey="AQAAAB"></FolderId></m:SavedItemFolderId>`)
+
+	have, err = xml.Marshal(MoveItem{ToFolderId: ToFolder(DistinguishedFolder(DistinguishedFolderId_DeletedItems))})
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:MoveItem><m:ToFolderId><DistinguishedFolderId Id="deleteditems"></DistinguishedFolderId></m:ToFolderId><m:ItemIds></m:ItemIds></m:MoveItem>`, string(have))
+}
+
+func TestValidateFolderId(t *testing.T) {
+	both := SavedItemFolderId{
+		FolderId:              NewFolderId("AAMkAF", ""),
+		DistinguishedFolderId: NewDistinguishedFolderId(DistinguishedFolderId_Inbox),
+	}
+
+	_, err := xml.Marshal(both)
+	assert.True(t, errors.Is(err, ErrAmbiguousFolderId))
+	_, err = xml.Marshal(ToFolder(both))
+	assert.True(t, errors.Is(err, ErrAmbiguousFolderId))
+	_, err = xml.Marshal(MoveItem{})
+	assert.True(t, errors.Is(err, ErrMissingFolderId))
+
+	have, err := xml.Marshal(SavedItemFolderId{})
+	assert.NoError(t, err)
+	assert.Empty(t, have)
+}
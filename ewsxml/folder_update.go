@@ -0,0 +1,113 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The UpdateFolder element defines a request to update folders in a mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updatefolder
+type UpdateFolder struct {
+	XMLName       xml.Name       `xml:"m:UpdateFolder"`
+	FolderChanges []FolderChange `xml:"m:FolderChanges>FolderChange"`
+}
+
+// The FolderChange element contains a folder identifier and the updates to
+// apply to the folder. Only one of FolderId and DistinguishedFolderId should
+// be set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/folderchange
+type FolderChange struct {
+	XMLName               xml.Name               `xml:"FolderChange"`
+	FolderId              *FolderId              `xml:",omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:",omitempty"`
+	Updates               FolderUpdates          `xml:"Updates"`
+}
+
+// The FolderUpdates type contains a set of elements that define append, set,
+// and delete changes for folder properties.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updates-folder
+type FolderUpdates struct {
+	AppendToFolderField []AppendToFolderField `xml:",omitempty"`
+	SetFolderField      []SetFolderField      `xml:",omitempty"`
+	DeleteFolderField   []DeleteFolderField   `xml:",omitempty"`
+}
+
+// SetField adds a SetFolderField which replaces the value of field with the
+// value of the same property in f.
+func (u *FolderUpdates) SetField(field FieldUri, f Folder) *FolderUpdates {
+	u.SetFolderField = append(u.SetFolderField, SetFolderField{
+		FieldURI: FieldURI{FieldURI: field},
+		Folder:   &f,
+	})
+	return u
+}
+
+// SetDisplayName adds a SetFolderField which renames the folder.
+func (u *FolderUpdates) SetDisplayName(name string) *FolderUpdates {
+	return u.SetField(FieldUri_Folder_DisplayName, Folder{DisplayName: name})
+}
+
+// AppendToField adds an AppendToFolderField which appends the value of the
+// same property in f to field.
+func (u *FolderUpdates) AppendToField(field FieldUri, f Folder) *FolderUpdates {
+	u.AppendToFolderField = append(u.AppendToFolderField, AppendToFolderField{
+		FieldURI: FieldURI{FieldURI: field},
+		Folder:   &f,
+	})
+	return u
+}
+
+// DeleteField adds a DeleteFolderField which removes field from the folder.
+func (u *FolderUpdates) DeleteField(field FieldUri) *FolderUpdates {
+	u.DeleteFolderField = append(u.DeleteFolderField, DeleteFolderField{
+		FieldURI: FieldURI{FieldURI: field},
+	})
+	return u
+}
+
+// The SetFolderField element represents an update to a single property on a
+// folder. The folder element must only contain the property that is
+// identified by FieldURI.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/setfolderfield
+type SetFolderField struct {
+	XMLName  xml.Name `xml:"SetFolderField"`
+	FieldURI FieldURI
+	Folder   *Folder `xml:",omitempty"`
+}
+
+// The AppendToFolderField element represents data to append to a folder
+// property during an UpdateFolder operation.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/appendtofolderfield
+type AppendToFolderField struct {
+	XMLName  xml.Name `xml:"AppendToFolderField"`
+	FieldURI FieldURI
+	Folder   *Folder `xml:",omitempty"`
+}
+
+// The DeleteFolderField element represents an operation to delete a property
+// from a folder during an UpdateFolder operation.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deletefolderfield
+type DeleteFolderField struct {
+	XMLName  xml.Name `xml:"DeleteFolderField"`
+	FieldURI FieldURI
+}
+
+// The UpdateFolderResponseMessage element contains the status and result of
+// a single UpdateFolder operation request. Folders contains the FolderId,
+// including the new ChangeKey, of the updated folder.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updatefolderresponsemessage
+type UpdateFolderResponseMessage struct {
+	ResponseMessage
+	Folders Folders
+}
+
+// The MarkAllItemsAsRead element defines a request to set the read state of
+// all items in the folders. Read receipts are not sent when
+// SuppressReadReceipts is set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/markallitemsasread
+type MarkAllItemsAsRead struct {
+	XMLName               xml.Name                `xml:"m:MarkAllItemsAsRead"`
+	ReadFlag              bool                    `xml:"m:ReadFlag"`
+	SuppressReadReceipts  bool                    `xml:"m:SuppressReadReceipts"`
+	FolderId              []FolderId              `xml:"m:FolderIds>FolderId,omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:"m:FolderIds>DistinguishedFolderId,omitempty"`
+}
@@ -8,9 +8,19 @@ type Version string
 
 func (v Version) String() string { return string(v) }
 
+// The MailboxCulture element represents the culture, like "en-US", to use when
+// accessing a mailbox. It affects culture-sensitive values in responses, like
+// the display names of distinguished folders.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/mailboxculture
+type MailboxCulture string
+
+func (c MailboxCulture) String() string { return string(c) }
+
 type Header struct {
-	XMLName               xml.Name `xml:"soap:Header"`
+	XMLName               xml.Name  `xml:"soap:Header"`
+	Security              *Security `xml:",omitempty"`
 	RequestServerVersion  RequestServerVersion
+	MailboxCulture        MailboxCulture         `xml:",omitempty"`
 	ExchangeImpersonation *ExchangeImpersonation `xml:",omitempty"`
 	TimeZoneContext       *TimeZoneContext       `xml:",omitempty"`
 	DateTimePrecision     DateTimePrecision      `xml:",omitempty"`
@@ -23,25 +33,50 @@ func (h *Header) WithServerVersion(ver Version) *Header {
 	return h
 }
 
+func (h *Header) WithMailboxCulture(c MailboxCulture) *Header {
+	h.MailboxCulture = c
+	return h
+}
+
+// WithUsernameToken sets the WS-Security header, which authenticates the
+// request with a UsernameToken containing user and a plain text pass.
+func (h *Header) WithUsernameToken(user, pass string) *Header {
+	h.Security = &Security{
+		Xmlns: WSSecurityNamespace,
+		UsernameToken: UsernameToken{
+			Username: user,
+			Password: UsernameTokenPassword{Type: PasswordTextType, Value: pass},
+		},
+	}
+	return h
+}
+
 func (h *Header) DiscardImpersonation() *Header {
 	h.ExchangeImpersonation = nil
 	return h
 }
 
-func (h *Header) WithImpersonateSmtpAddress(v string) *Header {
-	if h.ExchangeImpersonation == nil {
-		h.ExchangeImpersonation = new(ExchangeImpersonation)
-	}
-	h.ExchangeImpersonation.ConnectingSID.SmtpAddress = v
+// WithImpersonation sets the ExchangeImpersonation header, which is used to
+// act as the user identified by sid.
+func (h *Header) WithImpersonation(sid ConnectingSID) *Header {
+	h.ExchangeImpersonation = &ExchangeImpersonation{ConnectingSID: sid}
 	return h
 }
 
+func (h *Header) WithImpersonatePrincipalName(v string) *Header {
+	return h.WithImpersonation(ConnectingSID{PrincipalName: v})
+}
+
+func (h *Header) WithImpersonateSID(v string) *Header {
+	return h.WithImpersonation(ConnectingSID{SID: v})
+}
+
+func (h *Header) WithImpersonateSmtpAddress(v string) *Header {
+	return h.WithImpersonation(ConnectingSID{SmtpAddress: v})
+}
+
 func (h *Header) WithImpersonatePrimarySmtpAddress(v string) *Header {
-	if h.ExchangeImpersonation == nil {
-		h.ExchangeImpersonation = new(ExchangeImpersonation)
-	}
-	h.ExchangeImpersonation.ConnectingSID.PrimarySmtpAddress = v
-	return h
+	return h.WithImpersonation(ConnectingSID{PrimarySmtpAddress: v})
 }
 
 func (h *Header) DiscardTimeZone() *Header {
@@ -60,14 +95,25 @@ func (h *Header) WithTimeZoneId(id TimeZoneId) *Header {
 	return h
 }
 
+// WithDateTimePrecision sets the DateTimePrecision header. Use
+// DateTimePrecision_Milliseconds to receive date/time values with fractional
+// seconds.
+func (h *Header) WithDateTimePrecision(p DateTimePrecision) *Header {
+	h.DateTimePrecision = p
+	return h
+}
+
 type RequestServerVersion struct {
 	Version Version `xml:",attr"`
 }
 
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/exchangeimpersonation
 type ExchangeImpersonation struct {
 	ConnectingSID ConnectingSID `xml:",omitempty"`
 }
 
+// The ConnectingSID element represents the account to impersonate. Only one
+// of its fields should be set.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/connectingsid
 type ConnectingSID struct {
 	PrincipalName      string `xml:",omitempty"`
@@ -75,3 +121,44 @@ type ConnectingSID struct {
 	SmtpAddress        string `xml:",omitempty"`
 	PrimarySmtpAddress string `xml:",omitempty"`
 }
+
+// The ServerVersionInfo element provides information about the version of the
+// server that handled a request. It is part of the SOAP header of every
+// response.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/serverversioninfo
+type ServerVersionInfo struct {
+	MajorVersion     int     `xml:",attr"`
+	MinorVersion     int     `xml:",attr"`
+	MajorBuildNumber int     `xml:",attr"`
+	MinorBuildNumber int     `xml:",attr"`
+	Version          Version `xml:",attr"`
+}
+
+//goland:noinspection GoUnusedConst
+const (
+	// WSSecurityNamespace is the namespace of the WS-Security header elements.
+	WSSecurityNamespace = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd"
+	// PasswordTextType indicates the UsernameTokenPassword contains the
+	// password in plain text.
+	PasswordTextType = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText"
+)
+
+// Security is the WS-Security header which authenticates a request using a
+// UsernameToken, as an alternative to http authentication.
+// https://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0.pdf
+type Security struct {
+	XMLName       xml.Name      `xml:"wsse:Security"`
+	Xmlns         string        `xml:"xmlns:wsse,attr"`
+	UsernameToken UsernameToken `xml:"wsse:UsernameToken"`
+}
+
+// UsernameToken contains the credentials of a WS-Security header.
+type UsernameToken struct {
+	Username string                `xml:"wsse:Username"`
+	Password UsernameTokenPassword `xml:"wsse:Password"`
+}
+
+type UsernameTokenPassword struct {
+	Type  string `xml:",attr"`
+	Value string `xml:",chardata"`
+}
@@ -0,0 +1,81 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeader_WithImpersonation(t *testing.T) {
+	var h Header
+	h.WithServerVersion("Exchange2013").
+		WithImpersonateSmtpAddress("other@example.com").
+		WithImpersonatePrincipalName("user@example.com")
+
+	have, err := xml.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `<soap:Header><RequestServerVersion Version="Exchange2013"></RequestServerVersion>`+
+		`<ExchangeImpersonation><ConnectingSID><PrincipalName>user@example.com</PrincipalName></ConnectingSID></ExchangeImpersonation>`+
+		`</soap:Header>`, string(have))
+}
+
+func TestHeader_WithTimeZoneId(t *testing.T) {
+	var h Header
+	h.WithServerVersion("Exchange2013").
+		WithTimeZoneId("").
+		WithTimeZoneId("W. Europe Standard Time")
+
+	have, err := xml.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `<soap:Header><RequestServerVersion Version="Exchange2013"></RequestServerVersion>`+
+		`<TimeZoneContext><TimeZoneDefinition Id="W. Europe Standard Time"></TimeZoneDefinition></TimeZoneContext>`+
+		`</soap:Header>`, string(have))
+
+	h.DiscardTimeZone()
+	have, err = xml.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `<soap:Header><RequestServerVersion Version="Exchange2013"></RequestServerVersion></soap:Header>`, string(have))
+}
+
+func TestHeader_WithMailboxCulture(t *testing.T) {
+	var h Header
+	h.WithServerVersion("Exchange2013").WithMailboxCulture("en-US")
+
+	have, err := xml.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `<soap:Header><RequestServerVersion Version="Exchange2013"></RequestServerVersion>`+
+		`<MailboxCulture>en-US</MailboxCulture>`+
+		`</soap:Header>`, string(have))
+}
+
+func TestHeader_WithDateTimePrecision(t *testing.T) {
+	var h Header
+	h.WithServerVersion("Exchange2013").WithDateTimePrecision(DateTimePrecision_Milliseconds)
+
+	have, err := xml.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `<soap:Header><RequestServerVersion Version="Exchange2013"></RequestServerVersion>`+
+		`<DateTimePrecision>Milliseconds</DateTimePrecision>`+
+		`</soap:Header>`, string(have))
+
+	h.WithDateTimePrecision(DateTimePrecision_Default)
+	have, err = xml.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `<soap:Header><RequestServerVersion Version="Exchange2013"></RequestServerVersion></soap:Header>`, string(have))
+}
+
+func TestHeader_WithUsernameToken(t *testing.T) {
+	var h Header
+	h.WithServerVersion("Exchange2013").WithUsernameToken("user", "s3cr<t")
+
+	have, err := xml.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `<soap:Header>`+
+		`<wsse:Security xmlns:wsse="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd"><wsse:UsernameToken>`+
+		`<wsse:Username>user</wsse:Username>`+
+		`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText">s3cr&lt;t</wsse:Password>`+
+		`</wsse:UsernameToken></wsse:Security>`+
+		`<RequestServerVersion Version="Exchange2013"></RequestServerVersion>`+
+		`</soap:Header>`, string(have))
+}
@@ -2,6 +2,8 @@ package ewsxml
 
 import (
 	"encoding/xml"
+	"reflect"
+	"time"
 )
 
 type MessageDisposition string
@@ -12,6 +14,18 @@ type SendMeetingInvitations string
 
 func (s SendMeetingInvitations) String() string { return string(s) }
 
+// The Importance element describes the importance of an item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/importance
+type Importance string
+
+func (s Importance) String() string { return string(s) }
+
+// The Sensitivity element indicates the sensitivity level of an item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sensitivity
+type Sensitivity string
+
+func (s Sensitivity) String() string { return string(s) }
+
 //goland:noinspection GoUnusedConst,GoSnakeCaseUsage
 const (
 	// MessageDisposition_SaveOnly indicates the item is updated and saved back
@@ -34,6 +48,22 @@ const (
 	// request is sent to all attendees and a copy is saved in the folder that
 	// is identified by the SavedItemFolderId element.
 	SendMeetingInvitations_SendToAllAndSaveCopy SendMeetingInvitations = "SendToAllAndSaveCopy"
+
+	// Importance_Low indicates the item has a low level of importance.
+	Importance_Low Importance = "Low"
+	// Importance_Normal indicates the item has a normal level of importance.
+	Importance_Normal Importance = "Normal"
+	// Importance_High indicates the item has a high level of importance.
+	Importance_High Importance = "High"
+
+	// Sensitivity_Normal indicates the item has a normal sensitivity.
+	Sensitivity_Normal Sensitivity = "Normal"
+	// Sensitivity_Personal indicates the item is personal.
+	Sensitivity_Personal Sensitivity = "Personal"
+	// Sensitivity_Private indicates the item is private.
+	Sensitivity_Private Sensitivity = "Private"
+	// Sensitivity_Confidential indicates the item is confidential.
+	Sensitivity_Confidential Sensitivity = "Confidential"
 )
 
 // The SavedItemFolderId element identifies the target folder for operations
@@ -45,6 +75,33 @@ type SavedItemFolderId struct {
 	DistinguishedFolderId *DistinguishedFolderId `xml:",omitempty"`
 }
 
+// FolderById returns a SavedItemFolderId which targets the folder with the
+// given id and optional change key.
+func FolderById(id, changeKey string) SavedItemFolderId {
+	return SavedItemFolderId{FolderId: NewFolderId(id, changeKey)}
+}
+
+// DistinguishedFolder returns a SavedItemFolderId which targets the
+// distinguished folder with the given name.
+func DistinguishedFolder(name DistinguishedFolderId_Id) SavedItemFolderId {
+	return SavedItemFolderId{DistinguishedFolderId: NewDistinguishedFolderId(name)}
+}
+
+// MarshalXML omits the SavedItemFolderId element when no folder is set, in
+// which case Exchange saves the copy in the Sent Items folder. An
+// ErrAmbiguousFolderId error is returned when both folder ids are set.
+func (s SavedItemFolderId) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if s.FolderId == nil && s.DistinguishedFolderId == nil {
+		return nil
+	}
+	if err := validateFolderId(s.FolderId, s.DistinguishedFolderId); err != nil {
+		return err
+	}
+
+	type savedItemFolderId SavedItemFolderId
+	return e.EncodeElement(savedItemFolderId(s), start)
+}
+
 // The CreateItem element defines a request to create an item in the Exchange
 // store.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createitem
@@ -61,16 +118,150 @@ type Items struct {
 	// Item                Item                `xml:"Item"`
 	Message      []Message      `xml:",omitempty"`
 	CalendarItem []CalendarItem `xml:",omitempty"`
-	// Contact             Contact
+	Contact      []Contact      `xml:",omitempty"`
 	// DistributionList    DistributionList
-	// MeetingMessage      MeetingMessage
-	// MeetingRequest      MeetingRequest
-	// MeetingResponse     MeetingResponse
-	// MeetingCancellation MeetingCancellation
-	// Task                Task
-	// PostItem            PostItem
+	MeetingMessage      []MeetingMessage      `xml:",omitempty"`
+	MeetingRequest      []MeetingRequest      `xml:",omitempty"`
+	MeetingResponse     []MeetingResponse     `xml:",omitempty"`
+	MeetingCancellation []MeetingCancellation `xml:",omitempty"`
+	Task                []Task                `xml:",omitempty"`
+	PostItem            []PostItem            `xml:",omitempty"`
+
+	AcceptItem            []AcceptItem            `xml:",omitempty"`
+	TentativelyAcceptItem []TentativelyAcceptItem `xml:",omitempty"`
+	DeclineItem           []DeclineItem           `xml:",omitempty"`
+	CancelCalendarItem    []CancelCalendarItem    `xml:",omitempty"`
+	ForwardItem           []ForwardItem           `xml:",omitempty"`
+	ReplyToItem           []ReplyToItem           `xml:",omitempty"`
+	ReplyAllToItem        []ReplyAllToItem        `xml:",omitempty"`
+
+	// order contains a reference to each unmarshaled item, in the order in
+	// which they appeared in the response.
+	order []itemRef
+}
+
+// itemRef refers to an item by the name of the Items field which contains it
+// and its index within that field.
+type itemRef struct {
+	field string
+	index int
+}
+
+// UnmarshalXML appends each item element to the field of Items with the same
+// name, while recording the order of the elements so ItemIds can return them
+// in the order of the response.
+func (i *Items) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := reflect.ValueOf(i).Elem()
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch el := tok.(type) {
+		case xml.EndElement:
+			return nil
+
+		case xml.StartElement:
+			f := v.FieldByName(el.Name.Local)
+			if !f.IsValid() || f.Kind() != reflect.Slice {
+				if err = d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			x := reflect.New(f.Type().Elem())
+			if err = d.DecodeElement(x.Interface(), &el); err != nil {
+				return err
+			}
+			f.Set(reflect.Append(f, x.Elem()))
+			i.order = append(i.order, itemRef{field: el.Name.Local, index: f.Len() - 1})
+		}
+	}
+}
+
+// Len returns the total number of items.
+func (i *Items) Len() int {
+	return len(i.Message) + len(i.CalendarItem) + len(i.Contact) +
+		len(i.MeetingMessage) + len(i.MeetingRequest) + len(i.MeetingResponse) +
+		len(i.MeetingCancellation) + len(i.Task) + len(i.PostItem) +
+		len(i.AcceptItem) + len(i.TentativelyAcceptItem) + len(i.DeclineItem) +
+		len(i.CancelCalendarItem) + len(i.ForwardItem) + len(i.ReplyToItem) +
+		len(i.ReplyAllToItem)
 }
 
+// ItemIds returns the ItemId of each item which has one. Response objects,
+// such as AcceptItem, do not have an ItemId. When Items is unmarshaled from a
+// response, the ids are in the same order as the items in the response.
+// Otherwise, they are grouped by item type in the order of the fields of
+// Items.
+func (i *Items) ItemIds() []ItemId {
+	if len(i.order) != 0 && len(i.order) == i.Len() {
+		return i.orderedItemIds()
+	}
+
+	res := make([]ItemId, 0, i.Len())
+	add := func(id *ItemId) {
+		if id != nil {
+			res = append(res, *id)
+		}
+	}
+
+	for _, x := range i.Message {
+		add(x.ItemId)
+	}
+	for _, x := range i.CalendarItem {
+		add(x.ItemId)
+	}
+	for _, x := range i.Contact {
+		add(x.ItemId)
+	}
+	for _, x := range i.MeetingMessage {
+		add(x.ItemId)
+	}
+	for _, x := range i.MeetingRequest {
+		add(x.ItemId)
+	}
+	for _, x := range i.MeetingResponse {
+		add(x.ItemId)
+	}
+	for _, x := range i.MeetingCancellation {
+		add(x.ItemId)
+	}
+	for _, x := range i.Task {
+		add(x.ItemId)
+	}
+	for _, x := range i.PostItem {
+		add(x.ItemId)
+	}
+	return res
+}
+
+func (i *Items) orderedItemIds() []ItemId {
+	v := reflect.ValueOf(i).Elem()
+	res := make([]ItemId, 0, len(i.order))
+	for _, ref := range i.order {
+		f := v.FieldByName(ref.field)
+		if ref.index >= f.Len() {
+			continue
+		}
+		// not every type of item has an ItemId
+		x := f.Index(ref.index).FieldByName("ItemId")
+		if !x.IsValid() {
+			continue
+		}
+		if id, ok := x.Interface().(*ItemId); ok && id != nil {
+			res = append(res, *id)
+		}
+	}
+	return res
+}
+
+// The SendItem element defines a request to send draft messages that are
+// saved in the Exchange store. When SaveItemToFolder is true a copy is saved
+// in SavedItemFolderId, or the Sent Items folder when it is not set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/senditem
 type SendItem struct {
 	XMLName           xml.Name `xml:"m:SendItem"`
 	SaveItemToFolder  bool     `xml:",attr"`
@@ -78,7 +269,11 @@ type SendItem struct {
 	SavedItemFolderId SavedItemFolderId
 }
 
+// The ItemIds element contains the identifiers of the items of a request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/itemids
 type ItemIds struct {
+	XMLName xml.Name `xml:"m:ItemIds"`
+	ItemId  []ItemId
 }
 
 // The ItemId element contains the unique identifier and change key of an item
@@ -93,9 +288,9 @@ type ItemId struct {
 // item.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/occurrenceitemid
 type OccurrenceItemId struct {
-	XMLName           xml.Name `xml:"m:OccurrenceItemId"`
+	XMLName           xml.Name `xml:"OccurrenceItemId"`
 	RecurringMasterId string   `xml:",attr"`
-	ChangeKey         string   `xml:",attr"`
+	ChangeKey         string   `xml:",attr,omitempty"`
 	InstanceIndex     uint     `xml:",attr"`
 }
 
@@ -103,7 +298,49 @@ type OccurrenceItemId struct {
 // identifying the identifiers of one of its related occurrence items.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/recurringmasteritemid
 type RecurringMasterItemId struct {
-	XMLName      xml.Name `xml:"m:RecurringMasterItemId"`
+	XMLName      xml.Name `xml:"RecurringMasterItemId"`
 	OccurrenceId string   `xml:",attr"`
 	ChangeKey    string   `xml:",attr,omitempty"`
 }
+
+// The FlagStatus element indicates the follow-up status of an item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/flagstatus
+type FlagStatus string
+
+func (s FlagStatus) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	FlagStatus_NotFlagged FlagStatus = "NotFlagged"
+	FlagStatus_Flagged    FlagStatus = "Flagged"
+	FlagStatus_Complete   FlagStatus = "Complete"
+)
+
+// The Flag element contains the follow-up flag of an item. It can be set using
+// UpdateItem with FieldUri_Item_Flag and requires Exchange2013 or later.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/flag
+type Flag struct {
+	FlagStatus   FlagStatus
+	StartDate    EwsDateTime `xml:",omitempty"`
+	DueDate      EwsDateTime `xml:",omitempty"`
+	CompleteDate EwsDateTime `xml:",omitempty"`
+}
+
+// NewFlag returns a Flag which flags an item for follow-up between start and
+// due.
+func NewFlag(start, due time.Time) *Flag {
+	return &Flag{
+		FlagStatus: FlagStatus_Flagged,
+		StartDate:  NewEwsDateTime(start),
+		DueDate:    NewEwsDateTime(due),
+	}
+}
+
+// CompletedFlag returns a Flag which marks the follow-up of an item as
+// completed at t.
+func CompletedFlag(t time.Time) *Flag {
+	return &Flag{
+		FlagStatus:   FlagStatus_Complete,
+		CompleteDate: NewEwsDateTime(t),
+	}
+}
@@ -0,0 +1,66 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// DeleteType describes how an item or folder is deleted.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteitem
+type DeleteType string
+
+func (s DeleteType) String() string { return string(s) }
+
+type SendMeetingCancellations string
+
+func (s SendMeetingCancellations) String() string { return string(s) }
+
+type AffectedTaskOccurrences string
+
+func (s AffectedTaskOccurrences) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// DeleteType_HardDelete indicates the item or folder is permanently
+	// removed from the store.
+	DeleteType_HardDelete DeleteType = "HardDelete"
+	// DeleteType_SoftDelete indicates the item or folder is moved to the
+	// dumpster if the dumpster is enabled.
+	DeleteType_SoftDelete DeleteType = "SoftDelete"
+	// DeleteType_MoveToDeletedItems indicates the item or folder is moved to
+	// the Deleted Items folder.
+	DeleteType_MoveToDeletedItems DeleteType = "MoveToDeletedItems"
+
+	// SendMeetingCancellations_SendToNone indicates the calendar item is
+	// deleted without sending a cancellation message.
+	SendMeetingCancellations_SendToNone SendMeetingCancellations = "SendToNone"
+	// SendMeetingCancellations_SendOnlyToAll indicates the calendar item is
+	// deleted and a cancellation message is sent to all attendees.
+	SendMeetingCancellations_SendOnlyToAll SendMeetingCancellations = "SendOnlyToAll"
+	// SendMeetingCancellations_SendToAllAndSaveCopy indicates the calendar
+	// item is deleted and a cancellation message is sent to all attendees. A
+	// copy of the cancellation message is saved in the Sent Items folder.
+	SendMeetingCancellations_SendToAllAndSaveCopy SendMeetingCancellations = "SendToAllAndSaveCopy"
+
+	// AffectedTaskOccurrences_AllOccurrences indicates a delete item request
+	// deletes the master task, and therefore all recurring tasks that are
+	// associated with the master task.
+	AffectedTaskOccurrences_AllOccurrences AffectedTaskOccurrences = "AllOccurrences"
+	// AffectedTaskOccurrences_SpecifiedOccurrenceOnly indicates a delete item
+	// request deletes only specific occurrences of a task.
+	AffectedTaskOccurrences_SpecifiedOccurrenceOnly AffectedTaskOccurrences = "SpecifiedOccurrenceOnly"
+)
+
+// The DeleteItem element defines a request to delete an item from a mailbox in
+// the Exchange store. A single occurrence of a recurring calendar item is
+// deleted by adding its OccurrenceItemId.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteitem
+type DeleteItem struct {
+	XMLName                  xml.Name                 `xml:"m:DeleteItem"`
+	DeleteType               DeleteType               `xml:",attr"`
+	SendMeetingCancellations SendMeetingCancellations `xml:",attr,omitempty"`
+	AffectedTaskOccurrences  AffectedTaskOccurrences  `xml:",attr,omitempty"`
+	SuppressReadReceipts     bool                     `xml:",attr,omitempty"`
+
+	ItemId           []ItemId           `xml:"m:ItemIds>ItemId,omitempty"`
+	OccurrenceItemId []OccurrenceItemId `xml:"m:ItemIds>OccurrenceItemId,omitempty"`
+}
@@ -0,0 +1,31 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The GetItem element defines a request to get items from the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getitem
+type GetItem struct {
+	XMLName          xml.Name `xml:"m:GetItem"`
+	ItemShape        ItemShape
+	ItemId           []ItemId           `xml:"m:ItemIds>ItemId,omitempty"`
+	OccurrenceItemId []OccurrenceItemId `xml:"m:ItemIds>OccurrenceItemId,omitempty"`
+}
+
+// The GetItemResponseMessage element contains the status and result of a
+// single GetItem operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getitemresponsemessage
+type GetItemResponseMessage struct {
+	ResponseMessage
+	Items Items
+}
+
+// The MimeContent element contains the ASCII MIME stream of an object that is
+// represented in base64Binary format. It is returned when
+// ItemShape.IncludeMimeContent is set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/mimecontent
+type MimeContent struct {
+	CharacterSet string `xml:",attr,omitempty"`
+	Content      string `xml:",chardata"`
+}
@@ -0,0 +1,113 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetItem_MarshalXML(t *testing.T) {
+	item := GetItem{
+		ItemShape: ItemShape{
+			BaseShape:          BaseShape_AllProperties,
+			IncludeMimeContent: true,
+		},
+		ItemId: []ItemId{
+			{Id: "AAAlAF", ChangeKey: "CQAAAB"},
+			{Id: "AAAlAG"},
+		},
+	}
+
+	x, err := xml.MarshalIndent(item, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:GetItem>
+  <m:ItemShape>
+    <BaseShape>AllProperties</BaseShape>
+    <IncludeMimeContent>true</IncludeMimeContent>
+  </m:ItemShape>
+  <m:ItemIds>
+    <ItemId Id="AAAlAF" ChangeKey="CQAAAB"></ItemId>
+    <ItemId Id="AAAlAG"></ItemId>
+  </m:ItemIds>
+</m:GetItem>`, string(x))
+}
+
+func TestGetItemResponseMessage_UnmarshalXML(t *testing.T) {
+	const data = `<m:GetItemResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:Items>
+    <t:Message>
+      <t:MimeContent CharacterSet="UTF-8">UmVjZWl2ZWQ6IGZyb20=</t:MimeContent>
+      <t:ItemId Id="AAAlAF" ChangeKey="CQAAAB" />
+      <t:Subject>Company Soccer Team</t:Subject>
+    </t:Message>
+  </m:Items>
+</m:GetItemResponseMessage>`
+
+	var msg GetItemResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Equal(t, ResponseClass_Success, msg.ResponseClass)
+	assert.Equal(t, NoError, msg.ResponseCode)
+	assert.Len(t, msg.Items.Message, 1)
+	assert.Equal(t, &MimeContent{CharacterSet: "UTF-8", Content: "UmVjZWl2ZWQ6IGZyb20="}, msg.Items.Message[0].MimeContent)
+	assert.Equal(t, &ItemId{Id: "AAAlAF", ChangeKey: "CQAAAB"}, msg.Items.Message[0].ItemId)
+	assert.Equal(t, "Company Soccer Team", msg.Items.Message[0].Subject)
+}
+
+func TestItems_UnmarshalXML(t *testing.T) {
+	const data = `<m:Items xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:Task>
+    <t:ItemId Id="AAAtask" />
+    <t:Subject>Write report</t:Subject>
+    <t:DueDate>2023-06-01T17:00:00Z</t:DueDate>
+    <t:PercentComplete>50</t:PercentComplete>
+    <t:Status>InProgress</t:Status>
+  </t:Task>
+  <t:MeetingRequest>
+    <t:ItemId Id="AAAmeeting" />
+    <t:Subject>Planning</t:Subject>
+    <t:Sender><t:Mailbox><t:EmailAddress>organizer@example.com</t:EmailAddress></t:Mailbox></t:Sender>
+    <t:AssociatedCalendarItemId Id="AAAcalendar" />
+    <t:ResponseType>NoResponseReceived</t:ResponseType>
+    <t:MeetingRequestType>NewMeetingRequest</t:MeetingRequestType>
+    <t:Start>2023-06-02T09:00:00Z</t:Start>
+    <t:Location>Room 1</t:Location>
+  </t:MeetingRequest>
+  <t:PostItem>
+    <t:ItemId Id="AAApost" />
+    <t:From><t:Mailbox><t:EmailAddress>poster@example.com</t:EmailAddress></t:Mailbox></t:From>
+  </t:PostItem>
+  <t:Contact>
+    <t:ItemId Id="AAAcontact" />
+    <t:DisplayName>Jane Doe</t:DisplayName>
+  </t:Contact>
+</m:Items>`
+
+	var items Items
+	assert.NoError(t, xml.Unmarshal([]byte(data), &items))
+	assert.Equal(t, 4, items.Len())
+	// ids are in the order of the response, not grouped by item type
+	assert.Equal(t, []ItemId{{Id: "AAAtask"}, {Id: "AAAmeeting"}, {Id: "AAApost"}, {Id: "AAAcontact"}}, items.ItemIds())
+
+	task := items.Task[0]
+	assert.Equal(t, "Write report", task.Subject)
+	assert.Equal(t, TaskStatus_InProgress, task.Status)
+	assert.Equal(t, 50.0, task.PercentComplete)
+	assert.Equal(t, time.Date(2023, 6, 1, 17, 0, 0, 0, time.UTC), *task.DueDate)
+
+	req := items.MeetingRequest[0]
+	assert.Equal(t, "Planning", req.Subject)
+	assert.Equal(t, "organizer@example.com", req.Sender.EmailAddress)
+	assert.Equal(t, &ItemId{Id: "AAAcalendar"}, req.AssociatedCalendarItemId)
+	assert.Equal(t, ResponseType_NoResponseReceived, req.ResponseType)
+	assert.Equal(t, MeetingRequestType_NewMeetingRequest, req.MeetingRequestType)
+	assert.Equal(t, "Room 1", req.Location)
+
+	assert.Equal(t, "poster@example.com", items.PostItem[0].From.EmailAddress)
+	assert.Equal(t, "Jane Doe", items.Contact[0].DisplayName)
+}
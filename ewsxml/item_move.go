@@ -0,0 +1,100 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The ToFolderId element represents the destination folder for a copied or
+// moved item or folder. Only one of FolderId and DistinguishedFolderId should
+// be set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/tofolderid
+type ToFolderId struct {
+	XMLName               xml.Name               `xml:"m:ToFolderId"`
+	FolderId              *FolderId              `xml:",omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:",omitempty"`
+}
+
+// ToFolder returns a ToFolderId with the same folder id as f.
+func ToFolder(f SavedItemFolderId) ToFolderId {
+	return ToFolderId{
+		FolderId:              f.FolderId,
+		DistinguishedFolderId: f.DistinguishedFolderId,
+	}
+}
+
+// MarshalXML returns an ErrMissingFolderId or ErrAmbiguousFolderId error
+// unless exactly one of FolderId and DistinguishedFolderId is set.
+func (t ToFolderId) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := validateFolderId(t.FolderId, t.DistinguishedFolderId); err != nil {
+		return err
+	}
+
+	type toFolderId ToFolderId
+	return e.EncodeElement(toFolderId(t), start)
+}
+
+// The MoveItem element defines a request to move items in a mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/moveitem
+type MoveItem struct {
+	XMLName    xml.Name `xml:"m:MoveItem"`
+	ToFolderId ToFolderId
+	ItemId     []ItemId `xml:"m:ItemIds>ItemId"`
+}
+
+// The MoveItemResponseMessage element contains the status and result of a
+// single MoveItem operation request. Moved items get a new ItemId, which is
+// returned in Items.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/moveitemresponsemessage
+type MoveItemResponseMessage struct {
+	ResponseMessage
+	Items Items
+}
+
+// ItemIds returns the new ItemId of each moved item.
+func (r *MoveItemResponseMessage) ItemIds() []ItemId {
+	return r.Items.ItemIds()
+}
+
+// The CopyItem element defines a request to copy an item in a mailbox. The
+// original items are left in place.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyitem
+type CopyItem struct {
+	XMLName    xml.Name `xml:"m:CopyItem"`
+	ToFolderId ToFolderId
+	ItemId     []ItemId `xml:"m:ItemIds>ItemId"`
+}
+
+// The CopyItemResponseMessage element contains the status and result of a
+// single CopyItem operation request. The ItemId of each copy is returned in
+// Items.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyitemresponsemessage
+type CopyItemResponseMessage struct {
+	ResponseMessage
+	Items Items
+}
+
+// ItemIds returns the ItemId of each copied item.
+func (r *CopyItemResponseMessage) ItemIds() []ItemId {
+	return r.Items.ItemIds()
+}
+
+// The MarkAsJunk element defines a request to mark items as junk or not junk,
+// which adds their senders to or removes them from the blocked senders list.
+// When MoveItem is true the items are also moved to the Junk Email folder, or
+// back to the Inbox when IsJunk is false.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/markasjunk
+type MarkAsJunk struct {
+	XMLName  xml.Name `xml:"m:MarkAsJunk"`
+	IsJunk   bool     `xml:",attr"`
+	MoveItem bool     `xml:",attr"`
+	ItemId   []ItemId `xml:"m:ItemIds>ItemId"`
+}
+
+// The MarkAsJunkResponseMessage element contains the status and result of a
+// single item of a MarkAsJunk request. MovedItemId is only set when the item
+// is moved.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/markasjunkresponsemessage
+type MarkAsJunkResponseMessage struct {
+	ResponseMessage
+	MovedItemId *ItemId `xml:",omitempty"`
+}
@@ -0,0 +1,77 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyItem(t *testing.T) {
+	source := ItemId{Id: "AAAtAEFkbWluaX", ChangeKey: "CQAAABYAAAB"}
+
+	req := CopyItem{
+		ToFolderId: ToFolderId{
+			DistinguishedFolderId: new(DistinguishedFolderId).WithId(DistinguishedFolderId_Drafts),
+		},
+		ItemId: []ItemId{source},
+	}
+
+	x, err := xml.MarshalIndent(req, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CopyItem>
+  <m:ToFolderId>
+    <DistinguishedFolderId Id="drafts"></DistinguishedFolderId>
+  </m:ToFolderId>
+  <m:ItemIds>
+    <ItemId Id="AAAtAEFkbWluaX" ChangeKey="CQAAABYAAAB"></ItemId>
+  </m:ItemIds>
+</m:CopyItem>`, string(x))
+
+	const data = `<m:CopyItemResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:Items>
+    <t:Message>
+      <t:ItemId Id="AAAtAEFkbWluaY" ChangeKey="CQAAABYAAAC" />
+    </t:Message>
+  </m:Items>
+</m:CopyItemResponseMessage>`
+
+	var resp CopyItemResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &resp))
+	assert.Equal(t, NoError, resp.ResponseCode)
+
+	ids := resp.ItemIds()
+	assert.Len(t, ids, 1)
+	assert.NotEqual(t, source.Id, ids[0].Id)
+	assert.Equal(t, ItemId{Id: "AAAtAEFkbWluaY", ChangeKey: "CQAAABYAAAC"}, ids[0])
+}
+
+func TestMarkAsJunk(t *testing.T) {
+	req := MarkAsJunk{
+		IsJunk:   true,
+		MoveItem: true,
+		ItemId:   []ItemId{{Id: "AAAtAEFkbWluaX"}},
+	}
+
+	x, err := xml.MarshalIndent(req, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:MarkAsJunk IsJunk="true" MoveItem="true">
+  <m:ItemIds>
+    <ItemId Id="AAAtAEFkbWluaX"></ItemId>
+  </m:ItemIds>
+</m:MarkAsJunk>`, string(x))
+
+	const data = `<m:MarkAsJunkResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:MovedItemId Id="AAAtAEFkbWluaY" ChangeKey="CQAAABYAAAC" />
+</m:MarkAsJunkResponseMessage>`
+
+	var msg MarkAsJunkResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Equal(t, &ItemId{Id: "AAAtAEFkbWluaY", ChangeKey: "CQAAABYAAAC"}, msg.MovedItemId)
+}
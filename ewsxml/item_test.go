@@ -0,0 +1,29 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendItem_MarshalXML(t *testing.T) {
+	req := SendItem{
+		SaveItemToFolder: true,
+		ItemIds:          ItemIds{ItemId: []ItemId{{Id: "AAMkAD", ChangeKey: "CQAAAB"}}},
+		SavedItemFolderId: SavedItemFolderId{
+			DistinguishedFolderId: new(DistinguishedFolderId).WithId(DistinguishedFolderId_SentItems),
+		},
+	}
+
+	x, err := xml.MarshalIndent(req, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:SendItem SaveItemToFolder="true">
+  <m:ItemIds>
+    <ItemId Id="AAMkAD" ChangeKey="CQAAAB"></ItemId>
+  </m:ItemIds>
+  <m:SavedItemFolderId>
+    <DistinguishedFolderId Id="sentitems"></DistinguishedFolderId>
+  </m:SavedItemFolderId>
+</m:SendItem>`, string(x))
+}
@@ -2,6 +2,7 @@ package ewsxml
 
 import (
 	"encoding/xml"
+	"time"
 )
 
 type ConflictResolution string
@@ -50,26 +51,177 @@ const (
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateitem
 type UpdateItem struct {
 	XMLName                               xml.Name                              `xml:"m:UpdateItem"`
-	ConflictResolution                    ConflictResolution                    `xml:",attr"`
-	MessageDisposition                    MessageDisposition                    `xml:",attr"`
-	SendMeetingInvitationsOrCancellations SendMeetingInvitationsOrCancellations `xml:",attr"`
-	SuppressReadReceipts                  bool                                  `xml:",attr"`
+	ConflictResolution                    ConflictResolution                    `xml:",attr,omitempty"`
+	MessageDisposition                    MessageDisposition                    `xml:",attr,omitempty"`
+	SendMeetingInvitationsOrCancellations SendMeetingInvitationsOrCancellations `xml:",attr,omitempty"`
+	SuppressReadReceipts                  bool                                  `xml:",attr,omitempty"`
 
-	SavedItemFolderId SavedItemFolderId
-	ItemChanges       []ItemChange `xml:"m:ItemChanges"`
+	SavedItemFolderId *SavedItemFolderId `xml:",omitempty"`
+	ItemChanges       []ItemChange       `xml:"m:ItemChanges>ItemChange"`
 }
 
 // The ItemChange element contains an item identifier and the updates to apply
 // to the item.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/itemchange
 type ItemChange struct {
-	XMLName               xml.Name `xml:"m:ItemChange"`
-	ItemId                ItemId
-	OccurrenceItemId      OccurrenceItemId
-	RecurringMasterItemId RecurringMasterItemId
+	XMLName               xml.Name               `xml:"ItemChange"`
+	ItemId                *ItemId                `xml:",omitempty"`
+	OccurrenceItemId      *OccurrenceItemId      `xml:",omitempty"`
+	RecurringMasterItemId *RecurringMasterItemId `xml:",omitempty"`
 	Updates               Updates
 }
 
+// The Updates element contains a set of elements that define append, set, and
+// delete changes for item properties.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updates-item
 type Updates struct {
+	AppendToItemField []AppendToItemField `xml:",omitempty"`
+	SetItemField      []SetItemField      `xml:",omitempty"`
+	DeleteItemField   []DeleteItemField   `xml:",omitempty"`
+}
+
+// SetMessageField adds a SetItemField which replaces the value of field with
+// the value of the same property in m.
+func (u *Updates) SetMessageField(field FieldUri, m Message) *Updates {
+	u.SetItemField = append(u.SetItemField, SetItemField{
+		FieldURI: &FieldURI{FieldURI: field},
+		Message:  &m,
+	})
+	return u
+}
+
+// SetCalendarItemField adds a SetItemField which replaces the value of field
+// with the value of the same property in ci.
+func (u *Updates) SetCalendarItemField(field FieldUri, ci CalendarItem) *Updates {
+	u.SetItemField = append(u.SetItemField, SetItemField{
+		FieldURI:     &FieldURI{FieldURI: field},
+		CalendarItem: &ci,
+	})
+	return u
+}
+
+// SetContactField adds a SetItemField which replaces the value of field with
+// the value of the same property in c.
+func (u *Updates) SetContactField(field FieldUri, c Contact) *Updates {
+	u.SetItemField = append(u.SetItemField, SetItemField{
+		FieldURI: &FieldURI{FieldURI: field},
+		Contact:  &c,
+	})
+	return u
+}
+
+// SetTaskField adds a SetItemField which replaces the value of field with
+// the value of the same property in t.
+func (u *Updates) SetTaskField(field FieldUri, t Task) *Updates {
+	u.SetItemField = append(u.SetItemField, SetItemField{
+		FieldURI: &FieldURI{FieldURI: field},
+		Task:     &t,
+	})
+	return u
+}
+
+// CompleteTask adds SetItemFields which mark a task as completed at t. The
+// server sets its PercentComplete to 100.
+func (u *Updates) CompleteTask(t time.Time) *Updates {
+	return u.
+		SetTaskField(FieldUri_Task_Status, Task{Status: TaskStatus_Completed}).
+		SetTaskField(FieldUri_Task_CompleteDate, Task{CompleteDate: &t})
+}
+
+// AppendToMessageField adds an AppendToItemField which appends the value of
+// the same property in m to field.
+func (u *Updates) AppendToMessageField(field FieldUri, m Message) *Updates {
+	u.AppendToItemField = append(u.AppendToItemField, AppendToItemField{
+		FieldURI: &FieldURI{FieldURI: field},
+		Message:  &m,
+	})
+	return u
+}
+
+// AppendToCalendarItemField adds an AppendToItemField which appends the value
+// of the same property in ci to field.
+func (u *Updates) AppendToCalendarItemField(field FieldUri, ci CalendarItem) *Updates {
+	u.AppendToItemField = append(u.AppendToItemField, AppendToItemField{
+		FieldURI:     &FieldURI{FieldURI: field},
+		CalendarItem: &ci,
+	})
+	return u
+}
+
+// SetMessageExtendedProperty adds a SetItemField which sets the value of the
+// extended property p on a message.
+func (u *Updates) SetMessageExtendedProperty(p ExtendedProperty) *Updates {
+	u.SetItemField = append(u.SetItemField, SetItemField{
+		ExtendedFieldURI: &p.ExtendedFieldURI,
+		Message:          &Message{ExtendedProperty: ExtendedProperties{p}},
+	})
+	return u
+}
+
+// DeleteExtendedProperty adds a DeleteItemField which removes the extended
+// property identified by uri from the item.
+func (u *Updates) DeleteExtendedProperty(uri ExtendedFieldURI) *Updates {
+	u.DeleteItemField = append(u.DeleteItemField, DeleteItemField{
+		ExtendedFieldURI: &uri,
+	})
+	return u
+}
+
+// DeleteField adds a DeleteItemField which removes field from the item.
+func (u *Updates) DeleteField(field FieldUri) *Updates {
+	u.DeleteItemField = append(u.DeleteItemField, DeleteItemField{
+		FieldURI: &FieldURI{FieldURI: field},
+	})
+	return u
+}
+
+// The SetItemField element represents an update to a single property of an
+// item. The item element must only contain the property that is identified
+// by either FieldURI or ExtendedFieldURI.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/setitemfield
+type SetItemField struct {
+	XMLName          xml.Name          `xml:"SetItemField"`
+	FieldURI         *FieldURI         `xml:",omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI `xml:",omitempty"`
+	Message          *Message          `xml:",omitempty"`
+	CalendarItem     *CalendarItem     `xml:",omitempty"`
+	Contact          *Contact          `xml:",omitempty"`
+	Task             *Task             `xml:",omitempty"`
+}
+
+// The AppendToItemField element represents data to append to a single
+// property of an item during an UpdateItem operation.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/appendtoitemfield
+type AppendToItemField struct {
+	XMLName          xml.Name          `xml:"AppendToItemField"`
+	FieldURI         *FieldURI         `xml:",omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI `xml:",omitempty"`
+	Message          *Message          `xml:",omitempty"`
+	CalendarItem     *CalendarItem     `xml:",omitempty"`
+}
+
+// The DeleteItemField element represents an operation to delete a given
+// property from an item during an UpdateItem call.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteitemfield
+type DeleteItemField struct {
+	XMLName          xml.Name          `xml:"DeleteItemField"`
+	FieldURI         *FieldURI         `xml:",omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI `xml:",omitempty"`
+}
+
+// The UpdateItemResponseMessage element contains the status and result of a
+// single UpdateItem operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateitemresponsemessage
+type UpdateItemResponseMessage struct {
+	ResponseMessage
+	Items           Items
+	ConflictResults struct {
+		Count int
+	}
+}
+
+// ItemIds returns the ItemId, including the new ChangeKey, of each updated
+// item.
+func (r *UpdateItemResponseMessage) ItemIds() []ItemId {
+	return r.Items.ItemIds()
 }
@@ -0,0 +1,105 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateItem_MarshalXML(t *testing.T) {
+	var change ItemChange
+	change.ItemId = &ItemId{Id: "AAAtAE", ChangeKey: "CQAAAB"}
+	change.Updates.
+		SetMessageField(FieldUri_Item_Subject, Message{Subject: "Updated subject"}).
+		DeleteField(FieldUri_Item_Categories)
+
+	item := UpdateItem{
+		ConflictResolution: ConflictResolution_AlwaysOverwrite,
+		MessageDisposition: MessageDisposition_SaveOnly,
+		ItemChanges:        []ItemChange{change},
+	}
+
+	x, err := xml.MarshalIndent(item, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:UpdateItem ConflictResolution="AlwaysOverwrite" MessageDisposition="SaveOnly">
+  <m:ItemChanges>
+    <ItemChange>
+      <ItemId Id="AAAtAE" ChangeKey="CQAAAB"></ItemId>
+      <Updates>
+        <SetItemField>
+          <FieldURI FieldURI="item:Subject"></FieldURI>
+          <Message>
+            <Subject>Updated subject</Subject>
+          </Message>
+        </SetItemField>
+        <DeleteItemField>
+          <FieldURI FieldURI="item:Categories"></FieldURI>
+        </DeleteItemField>
+      </Updates>
+    </ItemChange>
+  </m:ItemChanges>
+</m:UpdateItem>`, string(x))
+}
+
+func TestUpdates_SetCalendarItemField(t *testing.T) {
+	var u Updates
+	u.SetCalendarItemField(FieldUri_Item_Subject, CalendarItem{Subject: "Moved meeting"})
+
+	x, err := xml.Marshal(u)
+	assert.NoError(t, err)
+	assert.Equal(t, `<Updates><SetItemField><FieldURI FieldURI="item:Subject"></FieldURI>`+
+		`<CalendarItem><Subject>Moved meeting</Subject></CalendarItem></SetItemField></Updates>`, string(x))
+}
+
+func TestUpdates_SetMessageField_Categories(t *testing.T) {
+	var u Updates
+	u.SetMessageField(FieldUri_Item_Categories, Message{Categories: NewCategories("Blue", "Red")})
+
+	x, err := xml.MarshalIndent(u, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<Updates>
+  <SetItemField>
+    <FieldURI FieldURI="item:Categories"></FieldURI>
+    <Message>
+      <Categories>
+        <String>Blue</String>
+        <String>Red</String>
+      </Categories>
+    </Message>
+  </SetItemField>
+</Updates>`, string(x))
+}
+
+func TestUpdates_SetMessageField_Flag(t *testing.T) {
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	var u Updates
+	u.SetMessageField(FieldUri_Item_Flag, Message{Flag: NewFlag(start, start.Add(8*time.Hour))}).
+		SetTaskField(FieldUri_Item_Flag, Task{Flag: CompletedFlag(start)})
+
+	x, err := xml.MarshalIndent(u, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<Updates>
+  <SetItemField>
+    <FieldURI FieldURI="item:Flag"></FieldURI>
+    <Message>
+      <Flag>
+        <FlagStatus>Flagged</FlagStatus>
+        <StartDate>2024-03-01T09:00:00Z</StartDate>
+        <DueDate>2024-03-01T17:00:00Z</DueDate>
+      </Flag>
+    </Message>
+  </SetItemField>
+  <SetItemField>
+    <FieldURI FieldURI="item:Flag"></FieldURI>
+    <Task>
+      <Flag>
+        <FlagStatus>Complete</FlagStatus>
+        <CompleteDate>2024-03-01T09:00:00Z</CompleteDate>
+      </Flag>
+    </Task>
+  </SetItemField>
+</Updates>`, string(x))
+}
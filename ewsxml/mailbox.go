@@ -1,5 +1,9 @@
 package ewsxml
 
+import (
+	"time"
+)
+
 // The RoutingType element represents the routing protocol for the recipient.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/routingtype-emailaddress
 type RoutingType string
@@ -49,10 +53,29 @@ type Mailbox struct {
 	ItemId       *ItemId     `xml:",omitempty"`
 }
 
+// EmailMailbox returns a Mailbox which is only identified by its email
+// address.
 func EmailMailbox(email string) *Mailbox {
 	return &Mailbox{EmailAddress: email}
 }
 
+// NamedMailbox returns a Mailbox with a display name and an email address.
+func NamedMailbox(name, email string) *Mailbox {
+	return &Mailbox{Name: name, EmailAddress: email}
+}
+
+// SmtpMailbox returns a Mailbox with an SMTP email address, which is routed
+// using the SMTP RoutingType.
+func SmtpMailbox(email string) *Mailbox {
+	return &Mailbox{EmailAddress: email, RoutingType: RoutingType_Smtp}
+}
+
+// IsDistributionList indicates if the Mailbox is a public or private
+// distribution list, whose members can be retrieved using ExpandDL.
+func (m *Mailbox) IsDistributionList() bool {
+	return m.MailboxType == MailboxType_PublicDL || m.MailboxType == MailboxType_PrivateDL
+}
+
 // OneMailbox is a wrapper with only a single Mailbox element inside.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sender
 // type OneMailbox struct {
@@ -61,10 +84,12 @@ func EmailMailbox(email string) *Mailbox {
 //
 // func One(m Mailbox) *OneMailbox { return &OneMailbox{Mailbox: m} }
 
+// The Attendee element represents attendees and resources for a meeting.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/attendee
 type Attendee struct {
-	Mailbox Mailbox
-	// <ResponseType/>
-	// <LastResponseTime/>
+	Mailbox          Mailbox
+	ResponseType     ResponseType `xml:",omitempty"`
+	LastResponseTime *time.Time   `xml:",omitempty"`
 }
 
 type Attendees struct {
@@ -81,3 +106,21 @@ func (a *Attendees) AddEmailAddress(email ...string) *Attendees {
 	}
 	return a
 }
+
+// Recipients is a collection of Mailbox elements, such as the ToRecipients,
+// CcRecipients and BccRecipients of an item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/torecipients
+type Recipients struct {
+	Mailbox []Mailbox
+}
+
+func NewRecipients(m ...Mailbox) *Recipients {
+	return &Recipients{Mailbox: m}
+}
+
+func (r *Recipients) AddEmailAddress(email ...string) *Recipients {
+	for _, e := range email {
+		r.Mailbox = append(r.Mailbox, *EmailMailbox(e))
+	}
+	return r
+}
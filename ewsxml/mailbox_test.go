@@ -0,0 +1,49 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMailbox_MarshalXML(t *testing.T) {
+	t.Run("email only", func(t *testing.T) {
+		x, err := xml.Marshal(EmailMailbox("john@example.com"))
+		assert.NoError(t, err)
+		assert.Equal(t, `<Mailbox><EmailAddress>john@example.com</EmailAddress></Mailbox>`, string(x))
+	})
+	t.Run("full", func(t *testing.T) {
+		m := SmtpMailbox("team@example.com")
+		m.Name = "Team"
+		m.MailboxType = MailboxType_PrivateDL
+		m.ItemId = &ItemId{Id: "AAAlAF", ChangeKey: "CQAAAB"}
+
+		x, err := xml.Marshal(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `<Mailbox><Name>Team</Name><EmailAddress>team@example.com</EmailAddress>`+
+			`<RoutingType>SMTP</RoutingType><MailboxType>PrivateDL</MailboxType>`+
+			`<ItemId Id="AAAlAF" ChangeKey="CQAAAB"></ItemId></Mailbox>`, string(x))
+		assert.True(t, m.IsDistributionList())
+	})
+}
+
+func TestMailbox_UnmarshalXML(t *testing.T) {
+	const data = `<t:Mailbox xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:Name>Public Folder</t:Name>
+  <t:EmailAddress>/o=Example/ou=Exchange/cn=Recipients/cn=folder</t:EmailAddress>
+  <t:RoutingType>EX</t:RoutingType>
+  <t:MailboxType>PublicFolder</t:MailboxType>
+</t:Mailbox>`
+
+	var m Mailbox
+	assert.NoError(t, xml.Unmarshal([]byte(data), &m))
+	assert.Equal(t, Mailbox{
+		Name:         "Public Folder",
+		EmailAddress: "/o=Example/ou=Exchange/cn=Recipients/cn=folder",
+		RoutingType:  RoutingType_EX,
+		MailboxType:  MailboxType_PublicFolder,
+	}, m)
+	assert.False(t, m.IsDistributionList())
+	assert.Equal(t, &Mailbox{Name: "John", EmailAddress: "john@example.com"}, NamedMailbox("John", "john@example.com"))
+}
@@ -0,0 +1,103 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// MailTipType identifies a type of mail tip.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/mailtipsrequested
+type MailTipType string
+
+func (s MailTipType) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// MailTipType_All represents all available mail tips.
+	MailTipType_All MailTipType = "All"
+	// MailTipType_OutOfOfficeMessage represents the Out of Office (OOF)
+	// message.
+	MailTipType_OutOfOfficeMessage MailTipType = "OutOfOfficeMessage"
+	// MailTipType_MailboxFullStatus represents the status for a mailbox that
+	// is full.
+	MailTipType_MailboxFullStatus MailTipType = "MailboxFullStatus"
+	// MailTipType_CustomMailTip represents a custom mail tip.
+	MailTipType_CustomMailTip MailTipType = "CustomMailTip"
+	// MailTipType_ExternalMemberCount represents the count of external
+	// members.
+	MailTipType_ExternalMemberCount MailTipType = "ExternalMemberCount"
+	// MailTipType_TotalMemberCount represents the count of all members.
+	MailTipType_TotalMemberCount MailTipType = "TotalMemberCount"
+	// MailTipType_MaxMessageSize represents the maximum message size a
+	// recipient can accept.
+	MailTipType_MaxMessageSize MailTipType = "MaxMessageSize"
+	// MailTipType_DeliveryRestriction represents the delivery restriction.
+	MailTipType_DeliveryRestriction MailTipType = "DeliveryRestriction"
+	// MailTipType_ModerationStatus represents the moderation status.
+	MailTipType_ModerationStatus MailTipType = "ModerationStatus"
+	// MailTipType_InvalidRecipient represents an invalid recipient.
+	MailTipType_InvalidRecipient MailTipType = "InvalidRecipient"
+)
+
+// MailTipTypes is a list of MailTipType values, which is marshaled as a
+// space separated string.
+type MailTipTypes []MailTipType
+
+func (m MailTipTypes) MarshalText() ([]byte, error) {
+	s := make([]string, len(m))
+	for i, t := range m {
+		s[i] = string(t)
+	}
+	return []byte(strings.Join(s, " ")), nil
+}
+
+func (m *MailTipTypes) UnmarshalText(text []byte) error {
+	*m = (*m)[:0]
+	for _, t := range strings.Fields(string(text)) {
+		*m = append(*m, MailTipType(t))
+	}
+	return nil
+}
+
+// The GetMailTips element defines a request to get mail tips for the
+// Recipients of a message which is sent as the SendingAs mailbox.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getmailtips
+type GetMailTips struct {
+	XMLName           xml.Name     `xml:"m:GetMailTips"`
+	SendingAs         Mailbox      `xml:"m:SendingAs"`
+	Recipients        []Mailbox    `xml:"m:Recipients>Mailbox"`
+	MailTipsRequested MailTipTypes `xml:"m:MailTipsRequested"`
+}
+
+// The MailTipsResponseMessageType element contains the status and result of
+// the mail tips of a single recipient.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/mailtipsresponsemessagetype
+type MailTipsResponseMessage struct {
+	ResponseMessage
+	MailTips MailTips
+}
+
+// The MailTips element contains the mail tips of a single recipient. Only the
+// mail tips which are requested using GetMailTips.MailTipsRequested are set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/mailtips
+type MailTips struct {
+	RecipientAddress    Mailbox
+	PendingMailTips     MailTipTypes
+	OutOfOffice         *OutOfOfficeMailTip `xml:",omitempty"`
+	MailboxFull         bool
+	CustomMailTip       string
+	TotalMemberCount    int
+	ExternalMemberCount int
+	MaxMessageSize      int
+	DeliveryRestricted  bool
+	IsModerated         bool
+	InvalidRecipient    bool
+}
+
+// The OutOfOffice element contains the OOF message of a recipient. Duration
+// is only set when the OOF message is scheduled.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/outofoffice
+type OutOfOfficeMailTip struct {
+	ReplyBody ReplyBody
+	Duration  *TimeWindow `xml:",omitempty"`
+}
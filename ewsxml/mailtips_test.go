@@ -0,0 +1,57 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMailTips_MarshalXML(t *testing.T) {
+	req := GetMailTips{
+		SendingAs:  *SmtpMailbox("john@example.com"),
+		Recipients: []Mailbox{*SmtpMailbox("jane@example.com")},
+		MailTipsRequested: MailTipTypes{
+			MailTipType_OutOfOfficeMessage,
+			MailTipType_MailboxFullStatus,
+		},
+	}
+
+	x, err := xml.MarshalIndent(req, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:GetMailTips>
+  <m:SendingAs>
+    <EmailAddress>john@example.com</EmailAddress>
+    <RoutingType>SMTP</RoutingType>
+  </m:SendingAs>
+  <m:Recipients>
+    <Mailbox>
+      <EmailAddress>jane@example.com</EmailAddress>
+      <RoutingType>SMTP</RoutingType>
+    </Mailbox>
+  </m:Recipients>
+  <m:MailTipsRequested>OutOfOfficeMessage MailboxFullStatus</m:MailTipsRequested>
+</m:GetMailTips>`, string(x))
+}
+
+func TestMailTipsResponseMessage_UnmarshalXML(t *testing.T) {
+	const data = `<m:MailTipsResponseMessageType ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:MailTips>
+    <t:RecipientAddress><t:EmailAddress>jane@example.com</t:EmailAddress><t:RoutingType>SMTP</t:RoutingType></t:RecipientAddress>
+    <t:PendingMailTips />
+    <t:OutOfOffice><t:ReplyBody><t:Message>I am on vacation.</t:Message></t:ReplyBody></t:OutOfOffice>
+    <t:MailboxFull>true</t:MailboxFull>
+  </m:MailTips>
+</m:MailTipsResponseMessageType>`
+
+	var msg MailTipsResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Equal(t, ResponseClass_Success, msg.ResponseClass)
+	assert.Equal(t, "jane@example.com", msg.MailTips.RecipientAddress.EmailAddress)
+	assert.Empty(t, msg.MailTips.PendingMailTips)
+	assert.Equal(t, "I am on vacation.", msg.MailTips.OutOfOffice.ReplyBody.Message)
+	assert.True(t, msg.MailTips.MailboxFull)
+}
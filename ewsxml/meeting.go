@@ -0,0 +1,143 @@
+package ewsxml
+
+import (
+	"time"
+)
+
+// The ResponseType element represents the type of recipient response that is
+// received for a meeting.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/responsetype
+type ResponseType string
+
+func (s ResponseType) String() string { return string(s) }
+
+// The MeetingRequestType element describes the type of the meeting request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/meetingrequesttype
+type MeetingRequestType string
+
+func (s MeetingRequestType) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	ResponseType_Unknown            ResponseType = "Unknown"
+	ResponseType_Organizer          ResponseType = "Organizer"
+	ResponseType_Tentative          ResponseType = "Tentative"
+	ResponseType_Accept             ResponseType = "Accept"
+	ResponseType_Decline            ResponseType = "Decline"
+	ResponseType_NoResponseReceived ResponseType = "NoResponseReceived"
+
+	MeetingRequestType_None                MeetingRequestType = "None"
+	MeetingRequestType_FullUpdate          MeetingRequestType = "FullUpdate"
+	MeetingRequestType_InformationalUpdate MeetingRequestType = "InformationalUpdate"
+	MeetingRequestType_NewMeetingRequest   MeetingRequestType = "NewMeetingRequest"
+	MeetingRequestType_Outdated            MeetingRequestType = "Outdated"
+	MeetingRequestType_SilentUpdate        MeetingRequestType = "SilentUpdate"
+	MeetingRequestType_PrincipalWantsCopy  MeetingRequestType = "PrincipalWantsCopy"
+)
+
+// The MeetingMessage element represents a meeting in the Exchange store. It
+// contains the properties which are shared by MeetingRequest,
+// MeetingResponse and MeetingCancellation.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/meetingmessage
+type MeetingMessage struct {
+	Message
+	AssociatedCalendarItemId *ItemId      `xml:",omitempty"`
+	IsDelegated              bool         `xml:",omitempty"`
+	IsOutOfDate              bool         `xml:",omitempty"`
+	HasBeenProcessed         bool         `xml:",omitempty"`
+	ResponseType             ResponseType `xml:",omitempty"`
+	UID                      string       `xml:",omitempty"`
+	// RecurrenceId  string
+	// DateTimeStamp string
+	// IsOrganizer   string
+}
+
+// The MeetingRequest element represents a meeting request in the Exchange
+// store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/meetingrequest
+type MeetingRequest struct {
+	MeetingMessage
+	MeetingRequestType     MeetingRequestType   `xml:",omitempty"`
+	IntendedFreeBusyStatus LegacyFreeBusyStatus `xml:",omitempty"`
+	Start                  *time.Time           `xml:",omitempty"`
+	End                    *time.Time           `xml:",omitempty"`
+	// OriginalStart         string
+	IsAllDayEvent        bool                 `xml:",omitempty"`
+	LegacyFreeBusyStatus LegacyFreeBusyStatus `xml:",omitempty"`
+	Location             string               `xml:",omitempty"`
+	// When                  string
+	// IsMeeting             string
+	// IsCancelled           string
+	// IsRecurring           string
+	// MeetingRequestWasSent string
+	CalendarItemType CalendarItemType `xml:",omitempty"`
+	// MyResponseType        string
+	Organizer         *Mailbox   `xml:"Organizer>Mailbox,omitempty"`
+	RequiredAttendees *Attendees `xml:",omitempty"`
+	OptionalAttendees *Attendees `xml:",omitempty"`
+}
+
+// The MeetingResponse element represents a meeting response in the Exchange
+// store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/meetingresponse
+type MeetingResponse struct {
+	MeetingMessage
+	Start    *time.Time `xml:",omitempty"`
+	End      *time.Time `xml:",omitempty"`
+	Location string     `xml:",omitempty"`
+	// Recurrence       string
+	CalendarItemType CalendarItemType `xml:",omitempty"`
+	ProposedStart    *time.Time       `xml:",omitempty"`
+	ProposedEnd      *time.Time       `xml:",omitempty"`
+}
+
+// The MeetingCancellation element represents a meeting cancellation in the
+// Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/meetingcancellation
+type MeetingCancellation struct {
+	MeetingMessage
+	Start    *time.Time `xml:",omitempty"`
+	End      *time.Time `xml:",omitempty"`
+	Location string     `xml:",omitempty"`
+	// Recurrence       string
+	CalendarItemType CalendarItemType `xml:",omitempty"`
+}
+
+// ResponseObject contains the elements which are shared by the response
+// objects that are used to respond to an item, such as AcceptItem,
+// TentativelyAcceptItem and DeclineItem. ReferenceItemId identifies the
+// item the response applies to.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/responseobjects
+type ResponseObject struct {
+	ItemClass       string      `xml:",omitempty"`
+	Sensitivity     Sensitivity `xml:",omitempty"`
+	Body            *Body       `xml:",omitempty"`
+	ReferenceItemId ItemId
+}
+
+// The AcceptItem element represents an Accept reply to a meeting request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/acceptitem
+type AcceptItem struct {
+	ResponseObject
+}
+
+// The TentativelyAcceptItem element represents a Tentative reply to a
+// meeting request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/tentativelyacceptitem
+type TentativelyAcceptItem struct {
+	ResponseObject
+}
+
+// The DeclineItem element represents a Decline reply to a meeting request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/declineitem
+type DeclineItem struct {
+	ResponseObject
+}
+
+// The CancelCalendarItem element represents the response object that is used
+// to cancel a meeting. The cancellation is sent to all attendees of the
+// meeting identified by ReferenceItemId.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/cancelcalendaritem
+type CancelCalendarItem struct {
+	ResponseObject
+}
@@ -0,0 +1,41 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcceptItem_MarshalXML(t *testing.T) {
+	var body Body
+	body.Text([]byte("See you there"))
+
+	item := CreateItem{
+		MessageDisposition: MessageDisposition_SendAndSaveCopy,
+		Items: Items{
+			AcceptItem: []AcceptItem{{ResponseObject{
+				Body:            &body,
+				ReferenceItemId: ItemId{Id: "AAAlAF", ChangeKey: "CQAAAB"},
+			}}},
+			DeclineItem: []DeclineItem{{ResponseObject{
+				ReferenceItemId: ItemId{Id: "AAAlAG"},
+			}}},
+		},
+	}
+
+	x, err := xml.MarshalIndent(item, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CreateItem MessageDisposition="SendAndSaveCopy">
+  <m:Items>
+    <AcceptItem>
+      <Body BodyType="Text" IsTruncated="false">See you there</Body>
+      <ReferenceItemId Id="AAAlAF" ChangeKey="CQAAAB"></ReferenceItemId>
+    </AcceptItem>
+    <DeclineItem>
+      <ReferenceItemId Id="AAAlAG"></ReferenceItemId>
+    </DeclineItem>
+  </m:Items>
+</m:CreateItem>`, string(x))
+	assert.Equal(t, 2, item.Items.Len())
+}
@@ -1,51 +1,57 @@
 package ewsxml
 
+import (
+	"strings"
+	"time"
+)
+
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/message-ex15websvcsotherref
 type Message struct {
-	// MimeContent string
-	// ItemId         ItemId
-	// ParentFolderId ParentFolderId
-	ItemClass   string
-	Subject     string
-	Sensitivity Sensitivity
-	Body        Body
-	// Attachments                  string
+	MimeContent    *MimeContent `xml:",omitempty"`
+	ItemId         *ItemId      `xml:",omitempty"`
+	ParentFolderId *ItemId      `xml:",omitempty"`
+	ItemClass      string       `xml:",omitempty"`
+	Subject        string       `xml:",omitempty"`
+	Sensitivity    Sensitivity  `xml:",omitempty"`
+	Body           *Body        `xml:",omitempty"`
+	Attachments    *Attachments `xml:",omitempty"`
 	// DateTimeReceived             string
 	// Size                         string
-	// Categories                   string
-	// Importance                   string
+	Categories Categories `xml:",omitempty"`
+	Importance Importance `xml:",omitempty"`
 	// InReplyTo                    string
 	// IsSubmitted                  string
 	// IsDraft                      string
 	// IsFromMe                     string
 	// IsResend                     string
 	// IsUnmodified                 string
-	// InternetMessageHeaders       string
+	InternetMessageHeaders *InternetMessageHeaders `xml:",omitempty"`
 	// DateTimeSent                 string
 	// DateTimeCreated              string
 	// ResponseObjects              string
-	// ReminderDueBy                string
-	// ReminderIsSet                string
-	// ReminderMinutesBeforeStart   string
+	ReminderDueBy              EwsDateTime `xml:",omitempty"`
+	ReminderIsSet              bool        `xml:",omitempty"`
+	ReminderMinutesBeforeStart Minutes     `xml:",omitempty"`
 	// DisplayCc                    string
 	// DisplayTo                    string
-	// HasAttachments               string
-	// ExtendedProperty             string
+	HasAttachments   bool               `xml:",omitempty"`
+	ExtendedProperty ExtendedProperties `xml:",omitempty"`
 	// Culture                      string
-	Sender       Mailbox `xml:"Sender>Mailbox"`
-	ToRecipients []Mailbox
-	// CcRecipients                 string
-	// BccRecipients                string
-	// IsReadReceiptRequested       string
-	// IsDeliveryReceiptRequested   string
+	Flag                       *Flag       `xml:",omitempty"`
+	Sender                     *Mailbox    `xml:"Sender>Mailbox,omitempty"`
+	ToRecipients               *Recipients `xml:",omitempty"`
+	CcRecipients               *Recipients `xml:",omitempty"`
+	BccRecipients              *Recipients `xml:",omitempty"`
+	IsReadReceiptRequested     bool        `xml:",omitempty"`
+	IsDeliveryReceiptRequested bool        `xml:",omitempty"`
 	// ConversationIndex            string
-	// ConversationTopic            string
-	// From                         string
-	// InternetMessageId            string
-	// IsRead                       string
+	ConversationTopic string   `xml:",omitempty"`
+	From              *Mailbox `xml:"From>Mailbox,omitempty"`
+	InternetMessageId string   `xml:",omitempty"`
+	IsRead            bool     `xml:",omitempty"`
 	// IsResponseRequested          string
 	// References                   string
-	// ReplyTo                      string
+	ReplyTo *Recipients `xml:",omitempty"`
 	// EffectiveRights              string
 	// ReceivedBy                   string
 	// ReceivedRepresenting         string
@@ -59,6 +65,12 @@ type Message struct {
 	// ReminderMessageData          string
 }
 
+// SetReminder sets a reminder which is due at t.
+func (m *Message) SetReminder(t time.Time) {
+	m.ReminderIsSet = true
+	m.ReminderDueBy = NewEwsDateTime(t)
+}
+
 // The BodyType element identifies how the body text is formatted in the
 // response.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/bodytype
@@ -83,3 +95,79 @@ func (b *Body) Text(contents []byte) {
 	b.BodyType = BodyType_Text
 	b.Contents = contents
 }
+
+// The InternetMessageHeader element represents both the name of the header
+// and the value of the header.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/internetmessageheader
+type InternetMessageHeader struct {
+	HeaderName string `xml:",attr"`
+	Value      string `xml:",chardata"`
+}
+
+// The InternetMessageHeaders element contains a collection of some of the
+// Internet message headers that are contained in an item in a mailbox. It is
+// only returned when requested using ItemShape.AdditionalProperties.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/internetmessageheaders
+type InternetMessageHeaders struct {
+	InternetMessageHeader []InternetMessageHeader
+}
+
+// Get returns the value of the first header with the given name. Header names
+// are matched case-insensitively.
+func (h *InternetMessageHeaders) Get(name string) string {
+	if v := h.Values(name); len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// Values returns the values of all headers with the given name, in the order
+// they appear. Header names are matched case-insensitively.
+func (h *InternetMessageHeaders) Values(name string) []string {
+	var res []string
+	for _, x := range h.InternetMessageHeader {
+		if strings.EqualFold(x.HeaderName, name) {
+			res = append(res, x.Value)
+		}
+	}
+	return res
+}
+
+// SmartResponse contains the elements which are shared by the response
+// objects that are used to reply to or forward a message. NewBodyContent is
+// added to the Body of the original item, which is identified by
+// ReferenceItemId.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/replytoitem
+type SmartResponse struct {
+	ItemClass       string      `xml:",omitempty"`
+	Subject         string      `xml:",omitempty"`
+	Sensitivity     Sensitivity `xml:",omitempty"`
+	Body            *Body       `xml:",omitempty"`
+	ToRecipients    *Recipients `xml:",omitempty"`
+	CcRecipients    *Recipients `xml:",omitempty"`
+	BccRecipients   *Recipients `xml:",omitempty"`
+	ReferenceItemId ItemId
+	NewBodyContent  *Body `xml:",omitempty"`
+}
+
+// The ForwardItem element contains an Exchange store item to forward to
+// recipients.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/forwarditem
+type ForwardItem struct {
+	SmartResponse
+}
+
+// The ReplyToItem element contains a reply to the sender of an item. The
+// reply is part of the same conversation as the original item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/replytoitem
+type ReplyToItem struct {
+	SmartResponse
+}
+
+// The ReplyAllToItem element contains a reply to the sender and all
+// identified recipients of an item. The reply is part of the same
+// conversation as the original item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/replyalltoitem
+type ReplyAllToItem struct {
+	SmartResponse
+}
@@ -0,0 +1,113 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplyAllToItem_MarshalXML(t *testing.T) {
+	var body Body
+	body.Html([]byte("<p>Thanks!</p>"))
+
+	item := CreateItem{
+		MessageDisposition: MessageDisposition_SendAndSaveCopy,
+		Items: Items{
+			ReplyAllToItem: []ReplyAllToItem{{SmartResponse{
+				CcRecipients:    NewRecipients().AddEmailAddress("jane@example.com"),
+				ReferenceItemId: ItemId{Id: "AAAlAF", ChangeKey: "CQAAAB"},
+				NewBodyContent:  &body,
+			}}},
+		},
+	}
+
+	x, err := xml.MarshalIndent(item, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CreateItem MessageDisposition="SendAndSaveCopy">
+  <m:Items>
+    <ReplyAllToItem>
+      <CcRecipients>
+        <Mailbox>
+          <EmailAddress>jane@example.com</EmailAddress>
+        </Mailbox>
+      </CcRecipients>
+      <ReferenceItemId Id="AAAlAF" ChangeKey="CQAAAB"></ReferenceItemId>
+      <NewBodyContent BodyType="HTML" IsTruncated="false">&lt;p&gt;Thanks!&lt;/p&gt;</NewBodyContent>
+    </ReplyAllToItem>
+  </m:Items>
+</m:CreateItem>`, string(x))
+}
+
+func TestMessage_MarshalXML(t *testing.T) {
+	var body Body
+	body.Html([]byte("<p>Hello!</p>"))
+
+	item := CreateItem{
+		MessageDisposition: MessageDisposition_SendAndSaveCopy,
+		Items: Items{
+			Message: []Message{{
+				Subject:      "Greetings",
+				Body:         &body,
+				Importance:   Importance_High,
+				ToRecipients: NewRecipients().AddEmailAddress("john@example.com", "jane@example.com"),
+			}},
+		},
+	}
+
+	x, err := xml.MarshalIndent(item, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CreateItem MessageDisposition="SendAndSaveCopy">
+  <m:Items>
+    <Message>
+      <Subject>Greetings</Subject>
+      <Body BodyType="HTML" IsTruncated="false">&lt;p&gt;Hello!&lt;/p&gt;</Body>
+      <Importance>High</Importance>
+      <ToRecipients>
+        <Mailbox>
+          <EmailAddress>john@example.com</EmailAddress>
+        </Mailbox>
+        <Mailbox>
+          <EmailAddress>jane@example.com</EmailAddress>
+        </Mailbox>
+      </ToRecipients>
+    </Message>
+  </m:Items>
+</m:CreateItem>`, string(x))
+}
+
+func TestMessage_Categories(t *testing.T) {
+	t.Run("unmarshal", func(t *testing.T) {
+		const data = `<t:Message xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:Subject>Invoice</t:Subject>
+  <t:Categories><t:String>Finance</t:String><t:String>Urgent</t:String></t:Categories>
+</t:Message>`
+
+		var msg Message
+		assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+		assert.Equal(t, NewCategories("Finance", "Urgent"), msg.Categories)
+	})
+	t.Run("omit empty", func(t *testing.T) {
+		x, err := xml.Marshal(Message{Subject: "Invoice", Categories: NewCategories()})
+		assert.NoError(t, err)
+		assert.Equal(t, `<Message><Subject>Invoice</Subject></Message>`, string(x))
+	})
+}
+
+func TestInternetMessageHeaders(t *testing.T) {
+	const data = `<t:Message xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:ItemId Id="AAAlAF" ChangeKey="CQAAAB" />
+  <t:InternetMessageHeaders>
+    <t:InternetMessageHeader HeaderName="Received">from mail.example.com</t:InternetMessageHeader>
+    <t:InternetMessageHeader HeaderName="Authentication-Results">spf=pass smtp.mailfrom=example.com</t:InternetMessageHeader>
+    <t:InternetMessageHeader HeaderName="Received">from relay.example.com</t:InternetMessageHeader>
+  </t:InternetMessageHeaders>
+</t:Message>`
+
+	var msg Message
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Len(t, msg.InternetMessageHeaders.InternetMessageHeader, 3)
+	assert.Equal(t, "spf=pass smtp.mailfrom=example.com", msg.InternetMessageHeaders.Get("authentication-results"))
+	assert.Equal(t, []string{"from mail.example.com", "from relay.example.com"}, msg.InternetMessageHeaders.Values("Received"))
+	assert.Equal(t, "", msg.InternetMessageHeaders.Get("X-Spam"))
+}
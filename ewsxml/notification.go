@@ -0,0 +1,265 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// EventType identifies an event that causes a notification.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/eventtype
+type EventType string
+
+func (s EventType) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// EventType_CopiedEvent represents an event in which an item or folder is
+	// copied.
+	EventType_CopiedEvent EventType = "CopiedEvent"
+	// EventType_CreatedEvent represents an event in which an item or folder is
+	// created.
+	EventType_CreatedEvent EventType = "CreatedEvent"
+	// EventType_DeletedEvent represents an event in which an item or folder is
+	// deleted.
+	EventType_DeletedEvent EventType = "DeletedEvent"
+	// EventType_ModifiedEvent represents an event in which an item or folder is
+	// modified.
+	EventType_ModifiedEvent EventType = "ModifiedEvent"
+	// EventType_MovedEvent represents an event in which an item or folder is
+	// moved from one parent folder to another parent folder.
+	EventType_MovedEvent EventType = "MovedEvent"
+	// EventType_NewMailEvent represents an event that is triggered by a new
+	// mail item in a mailbox.
+	EventType_NewMailEvent EventType = "NewMailEvent"
+	// EventType_FreeBusyChangedEvent represents an event in which the
+	// free/busy time of a calendar changed.
+	EventType_FreeBusyChangedEvent EventType = "FreeBusyChangedEvent"
+	// EventType_StatusEvent represents a notification that no new activity
+	// has occurred in the mailbox. It cannot be subscribed to.
+	EventType_StatusEvent EventType = "StatusEvent"
+
+	// SubscriptionStatus_OK indicates the subscription is active.
+	SubscriptionStatus_OK SubscriptionStatus = "OK"
+	// SubscriptionStatus_Unsubscribe indicates the subscription should be
+	// ended.
+	SubscriptionStatus_Unsubscribe SubscriptionStatus = "Unsubscribe"
+
+	// ConnectionStatus_OK indicates the streaming connection is open.
+	ConnectionStatus_OK ConnectionStatus = "OK"
+	// ConnectionStatus_Closed indicates the streaming connection is closed.
+	ConnectionStatus_Closed ConnectionStatus = "Closed"
+)
+
+// SubscriptionStatus describes the status of a push subscription.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/subscriptionstatus
+type SubscriptionStatus string
+
+func (s SubscriptionStatus) String() string { return string(s) }
+
+// FolderIds contains identifiers of folders, in the types namespace.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/folderids
+type FolderIds struct {
+	FolderId              []FolderId              `xml:",omitempty"`
+	DistinguishedFolderId []DistinguishedFolderId `xml:",omitempty"`
+}
+
+// The Subscribe element is used to subscribe client applications to either
+// push, pull or streaming notifications.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/subscribe
+type Subscribe struct {
+	XMLName                 xml.Name                 `xml:"m:Subscribe"`
+	PullSubscriptionRequest *PullSubscriptionRequest `xml:"m:PullSubscriptionRequest,omitempty"`
+	PushSubscriptionRequest *PushSubscriptionRequest `xml:"m:PushSubscriptionRequest,omitempty"`
+
+	StreamingSubscriptionRequest *StreamingSubscriptionRequest `xml:"m:StreamingSubscriptionRequest,omitempty"`
+}
+
+// The PullSubscriptionRequest element represents a subscription to a
+// pull-based event notification subscription. Timeout is the number of
+// minutes, between 1 and 1440, after which the subscription expires when
+// GetEvents is not called.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/pullsubscriptionrequest
+type PullSubscriptionRequest struct {
+	SubscribeToAllFolders bool        `xml:",attr,omitempty"`
+	FolderIds             *FolderIds  `xml:",omitempty"`
+	EventTypes            []EventType `xml:"EventTypes>EventType"`
+	Watermark             string      `xml:",omitempty"`
+	Timeout               int
+}
+
+// The PushSubscriptionRequest element represents a subscription to a
+// push-based event notification subscription. The server posts notifications
+// to URL and sends a status notification every StatusFrequency minutes when
+// no other events occurred.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/pushsubscriptionrequest
+type PushSubscriptionRequest struct {
+	SubscribeToAllFolders bool        `xml:",attr,omitempty"`
+	FolderIds             *FolderIds  `xml:",omitempty"`
+	EventTypes            []EventType `xml:"EventTypes>EventType"`
+	Watermark             string      `xml:",omitempty"`
+	StatusFrequency       int
+	URL                   string
+	CallerData            string `xml:",omitempty"`
+}
+
+// The StreamingSubscriptionRequest element represents a subscription to a
+// streaming event notification subscription. Use GetStreamingEvents to
+// receive the notifications.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/streamingsubscriptionrequest
+type StreamingSubscriptionRequest struct {
+	SubscribeToAllFolders bool        `xml:",attr,omitempty"`
+	FolderIds             *FolderIds  `xml:",omitempty"`
+	EventTypes            []EventType `xml:"EventTypes>EventType"`
+}
+
+// ConnectionStatus describes the status of a streaming connection.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/connectionstatus
+type ConnectionStatus string
+
+func (s ConnectionStatus) String() string { return string(s) }
+
+// The SubscribeResponseMessage element contains the status and result of a
+// single Subscribe operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/subscriberesponsemessage
+type SubscribeResponseMessage struct {
+	ResponseMessage
+	SubscriptionId string
+	Watermark      string `xml:",omitempty"`
+}
+
+// The Notification element contains information about the subscription and
+// the events that have occurred since the last notification. Events are in
+// the order in which they occurred.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/notification-ex15websvcsotherref
+type Notification struct {
+	SubscriptionId    string
+	PreviousWatermark string
+	MoreEvents        bool
+	Events            []NotificationEvent
+}
+
+// Watermark returns the Watermark of the last event, or PreviousWatermark when
+// there are no events.
+func (n *Notification) Watermark() string {
+	if len(n.Events) == 0 {
+		return n.PreviousWatermark
+	}
+	return n.Events[len(n.Events)-1].Watermark
+}
+
+func (n *Notification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch el := tok.(type) {
+		case xml.EndElement:
+			return nil
+
+		case xml.StartElement:
+			switch el.Name.Local {
+			case "SubscriptionId":
+				err = d.DecodeElement(&n.SubscriptionId, &el)
+			case "PreviousWatermark":
+				err = d.DecodeElement(&n.PreviousWatermark, &el)
+			case "MoreEvents":
+				err = d.DecodeElement(&n.MoreEvents, &el)
+			default:
+				ev := NotificationEvent{EventType: EventType(el.Name.Local)}
+				if err = d.DecodeElement(&ev, &el); err == nil {
+					n.Events = append(n.Events, ev)
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// NotificationEvent represents any of the events within a Notification. Which
+// fields are set depends on the EventType of the event.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/baseobjectchangedeventtype
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/movedcopiedeventtype
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/modifiedeventtype
+type NotificationEvent struct {
+	EventType         EventType `xml:"-"`
+	Watermark         string
+	TimeStamp         time.Time
+	FolderId          *FolderId `xml:",omitempty"`
+	ItemId            *ItemId   `xml:",omitempty"`
+	ParentFolderId    *FolderId `xml:",omitempty"`
+	OldFolderId       *FolderId `xml:",omitempty"`
+	OldItemId         *ItemId   `xml:",omitempty"`
+	OldParentFolderId *FolderId `xml:",omitempty"`
+	UnreadCount       int       `xml:",omitempty"`
+}
+
+// The SendNotification element is the body of a push notification which is
+// posted by the server, it contains a SendNotificationResponseMessage for
+// each notification.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sendnotification
+type SendNotification struct {
+	XMLName          xml.Name `xml:"SendNotification"`
+	ResponseMessages struct {
+		SendNotificationResponseMessage []SendNotificationResponseMessage
+	}
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sendnotificationresponsemessage
+type SendNotificationResponseMessage struct {
+	ResponseMessage
+	Notification Notification
+}
+
+// The SendNotificationResult element is the response of a client to a push
+// notification.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sendnotificationresult
+type SendNotificationResult struct {
+	XMLName            xml.Name           `xml:"m:SendNotificationResult"`
+	SubscriptionStatus SubscriptionStatus `xml:"m:SubscriptionStatus"`
+}
+
+// The GetStreamingEvents element represents the operation of retrieving
+// streaming events for one or more subscriptions. ConnectionTimeout is in
+// minutes and must be between 1 and 30.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getstreamingevents
+type GetStreamingEvents struct {
+	XMLName           xml.Name `xml:"m:GetStreamingEvents"`
+	SubscriptionIds   []string `xml:"m:SubscriptionIds>SubscriptionId"`
+	ConnectionTimeout int      `xml:"m:ConnectionTimeout"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getstreamingeventsresponsemessage
+type GetStreamingEventsResponseMessage struct {
+	ResponseMessage
+	ConnectionStatus     ConnectionStatus `xml:",omitempty"`
+	Notifications        []Notification   `xml:"Notifications>Notification"`
+	ErrorSubscriptionIds []string         `xml:"ErrorSubscriptionIds>SubscriptionId"`
+}
+
+// The GetEvents element is used to request event notifications from a pull
+// subscription. Watermark must be the watermark of the last event that was
+// received, or the one returned by Subscribe.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getevents
+type GetEvents struct {
+	XMLName        xml.Name `xml:"m:GetEvents"`
+	SubscriptionId string   `xml:"m:SubscriptionId"`
+	Watermark      string   `xml:"m:Watermark"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/geteventsresponsemessage
+type GetEventsResponseMessage struct {
+	ResponseMessage
+	Notification Notification
+}
+
+// The Unsubscribe element is used to end a pull or streaming notification
+// subscription.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/unsubscribe
+type Unsubscribe struct {
+	XMLName        xml.Name `xml:"m:Unsubscribe"`
+	SubscriptionId string   `xml:"m:SubscriptionId"`
+}
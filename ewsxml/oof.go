@@ -0,0 +1,69 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+type OofState string
+
+func (s OofState) String() string { return string(s) }
+
+type ExternalAudience string
+
+func (s ExternalAudience) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// OofState_Enabled indicates the user's OOF status is enabled.
+	OofState_Enabled OofState = "Enabled"
+	// OofState_Disabled indicates the user's OOF status is disabled.
+	OofState_Disabled OofState = "Disabled"
+	// OofState_Scheduled indicates the user's OOF status is enabled during the
+	// time period of OofSettings.Duration.
+	OofState_Scheduled OofState = "Scheduled"
+
+	// ExternalAudience_None indicates that no external senders receive an OOF
+	// message.
+	ExternalAudience_None ExternalAudience = "None"
+	// ExternalAudience_Known indicates that only external senders in the
+	// user's contacts receive an OOF message.
+	ExternalAudience_Known ExternalAudience = "Known"
+	// ExternalAudience_All indicates that all external senders receive an OOF
+	// message.
+	ExternalAudience_All ExternalAudience = "All"
+)
+
+// The GetUserOofSettingsRequest element contains the mailbox of the user
+// whose OOF settings are requested.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuseroofsettingsrequest
+type GetUserOofSettingsRequest struct {
+	XMLName xml.Name `xml:"m:GetUserOofSettingsRequest"`
+	Mailbox Email
+}
+
+// The SetUserOofSettingsRequest element contains the mailbox of the user and
+// the OOF settings which should be set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/setuseroofsettingsrequest
+type SetUserOofSettingsRequest struct {
+	XMLName         xml.Name `xml:"m:SetUserOofSettingsRequest"`
+	Mailbox         Email
+	UserOofSettings OofSettings
+}
+
+// The OofSettings element contains the OOF settings of a user. Duration is
+// only used when OofState is Scheduled.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/oofsettings
+type OofSettings struct {
+	OofState         OofState
+	ExternalAudience ExternalAudience
+	Duration         *TimeWindow `xml:",omitempty"`
+	InternalReply    ReplyBody
+	ExternalReply    ReplyBody
+}
+
+// The ReplyBody element contains the OOF response message.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/replybody
+type ReplyBody struct {
+	Lang    string `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Message string
+}
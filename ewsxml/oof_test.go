@@ -0,0 +1,32 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetUserOofSettingsRequest(t *testing.T) {
+	start := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	req := SetUserOofSettingsRequest{
+		Mailbox: Email{Address: "user@example.com"},
+		UserOofSettings: OofSettings{
+			OofState:         OofState_Scheduled,
+			ExternalAudience: ExternalAudience_Known,
+			Duration:         &TimeWindow{StartTime: start, EndTime: start.AddDate(0, 0, 14)},
+			InternalReply:    ReplyBody{Lang: "en-US", Message: "I am on vacation."},
+			ExternalReply:    ReplyBody{Message: "I am out of office."},
+		},
+	}
+
+	have, err := xml.Marshal(req)
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:SetUserOofSettingsRequest><Mailbox><Address>user@example.com</Address></Mailbox>`+
+		`<UserOofSettings><OofState>Scheduled</OofState><ExternalAudience>Known</ExternalAudience>`+
+		`<Duration><StartTime>2023-07-01T00:00:00Z</StartTime><EndTime>2023-07-15T00:00:00Z</EndTime></Duration>`+
+		`<InternalReply xml:lang="en-US"><Message>I am on vacation.</Message></InternalReply>`+
+		`<ExternalReply><Message>I am out of office.</Message></ExternalReply>`+
+		`</UserOofSettings></m:SetUserOofSettingsRequest>`, string(have))
+}
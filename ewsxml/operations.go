@@ -4,16 +4,38 @@ import (
 	"encoding/xml"
 )
 
+// The GetRoomLists element defines a request to get the room lists of the
+// organization.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getroomlists
+type GetRoomLists struct {
+	XMLName xml.Name `xml:"m:GetRoomLists"`
+}
+
+// The GetRooms element defines a request to get the rooms within the room
+// list which is identified by the EmailAddress of RoomList.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getrooms
 type GetRooms struct {
 	XMLName  xml.Name `xml:"m:GetRooms"`
-	RoomList struct {
-		EmailAddress string
-	} `xml:"m:RoomList"`
+	RoomList Mailbox  `xml:"m:RoomList"`
+}
+
+// The Room element represents a meeting room.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/room
+type Room struct {
+	Id Mailbox
 }
 
+// The GetServerTimeZones element represents a request to retrieve the time
+// zone definitions of the server. All time zones are returned when Ids is nil.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getservertimezones
 type GetServerTimeZones struct {
 	XMLName                xml.Name  `xml:"m:GetServerTimeZones"`
 	ReturnFullTimeZoneData bool      `xml:",attr,omitempty"`
 	Ids                    *[]string `xml:"m:Ids>Id,omitempty"`
 }
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getservertimezonesresponsemessage
+type GetServerTimeZonesResponseMessage struct {
+	ResponseMessage
+	TimeZoneDefinitions []TimeZoneDefinition `xml:"TimeZoneDefinitions>TimeZoneDefinition"`
+}
@@ -4,25 +4,47 @@ import (
 	"encoding/xml"
 )
 
+// The GetPersona element defines a request to retrieve the full set of
+// properties of a Persona.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getpersona
 type GetPersona struct {
 	XMLName   xml.Name  `xml:"m:GetPersona"`
 	PersonaId PersonaId `xml:"m:PersonaId"`
 }
 
+// The GetPersonaResponseMessage element contains the status and result of a
+// GetPersona request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getpersonaresponsemessage
+type GetPersonaResponseMessage struct {
+	ResponseMessage
+	Persona Persona
+}
+
+// The Persona element represents a single persona, which combines the
+// contacts and directory entries of a single person.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/persona
 type Persona struct {
 	PersonaId            PersonaId
+	PersonaType          string `xml:",omitempty"`
 	DisplayName          string
+	FileAs               string `xml:",omitempty"`
 	GivenName            string
 	Surname              string
+	CompanyName          string `xml:",omitempty"`
 	Title                string
 	Department           string
-	Departments          Departments
+	ImAddress            string `xml:",omitempty"`
 	EmailAddress         Mailbox
-	EmailAddresses       []Mailbox
+	EmailAddresses       []Mailbox `xml:"EmailAddresses>EmailAddress"`
 	RelevanceScore       int
-	BusinessPhoneNumbers BusinessPhoneNumbers
-	MobilePhones         MobilePhones
-	OfficeLocations      OfficeLocations
+	FileAses             StringAttributedValues
+	Departments          StringAttributedValues
+	OfficeLocations      StringAttributedValues
+	ImAddresses          StringAttributedValues
+	Birthdays            StringAttributedValues
+	BusinessPhoneNumbers PhoneNumberAttributedValues
+	MobilePhones         PhoneNumberAttributedValues
+	HomeAddresses        PostalAddressAttributedValues
 }
 
 type PersonaId struct {
@@ -30,12 +52,32 @@ type PersonaId struct {
 	ChangeKey string `xml:",attr,omitempty"`
 }
 
-type BusinessPhoneNumbers struct {
-	PhoneNumberAttributedValue PhoneNumberAttributedValue
+// Attributions contains the ids of the sources, like a contact or directory
+// entry, an attributed value of a Persona originates from.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/attributions
+type Attributions struct {
+	Attribution []string
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/stringattributedvalue
+type StringAttributedValue struct {
+	Value        string
+	Attributions Attributions
+}
+
+// StringAttributedValues contains all StringAttributedValue elements of a
+// multivalued Persona property, like Departments or ImAddresses.
+type StringAttributedValues struct {
+	StringAttributedValue []StringAttributedValue
 }
 
-type MobilePhones struct {
-	PhoneNumberAttributedValue PhoneNumberAttributedValue
+// Values returns the values of all StringAttributedValue elements.
+func (s StringAttributedValues) Values() []string {
+	res := make([]string, 0, len(s.StringAttributedValue))
+	for _, v := range s.StringAttributedValue {
+		res = append(res, v.Value)
+	}
+	return res
 }
 
 type Value struct {
@@ -43,18 +85,39 @@ type Value struct {
 	Type   string
 }
 
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/phonenumberattributedvalue
 type PhoneNumberAttributedValue struct {
-	Value Value
+	Value        Value
+	Attributions Attributions
 }
 
-type OfficeLocations struct {
-	StringAttributedValue StringAttributedValue
+// PhoneNumberAttributedValues contains all PhoneNumberAttributedValue
+// elements of a multivalued Persona property, like BusinessPhoneNumbers or
+// MobilePhones.
+type PhoneNumberAttributedValues struct {
+	PhoneNumberAttributedValue []PhoneNumberAttributedValue
 }
 
-type Departments struct {
-	StringAttributedValue StringAttributedValue
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/value-personapostaladdress
+type PersonaPostalAddress struct {
+	Street           string `xml:",omitempty"`
+	City             string `xml:",omitempty"`
+	State            string `xml:",omitempty"`
+	Country          string `xml:",omitempty"`
+	PostalCode       string `xml:",omitempty"`
+	PostOfficeBox    string `xml:",omitempty"`
+	Type             string `xml:",omitempty"`
+	FormattedAddress string `xml:",omitempty"`
 }
 
-type StringAttributedValue struct {
-	Value string
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/postaladdressattributedvalue
+type PostalAddressAttributedValue struct {
+	Value        PersonaPostalAddress
+	Attributions Attributions
+}
+
+// PostalAddressAttributedValues contains all PostalAddressAttributedValue
+// elements of a multivalued Persona property, like HomeAddresses.
+type PostalAddressAttributedValues struct {
+	PostalAddressAttributedValue []PostalAddressAttributedValue
 }
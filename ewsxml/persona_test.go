@@ -0,0 +1,82 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersona_UnmarshalXML(t *testing.T) {
+	const data = `<t:Persona xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:PersonaId Id="AAUQAF" />
+  <t:DisplayName>Jane Doe</t:DisplayName>
+  <t:EmailAddresses>
+    <t:EmailAddress><t:Name>Jane Doe</t:Name><t:EmailAddress>jane@example.com</t:EmailAddress></t:EmailAddress>
+    <t:EmailAddress><t:Name>Jane</t:Name><t:EmailAddress>jane@example.org</t:EmailAddress></t:EmailAddress>
+  </t:EmailAddresses>
+  <t:BusinessPhoneNumbers>
+    <t:PhoneNumberAttributedValue>
+      <t:Value><t:Number>+31 20 1234567</t:Number><t:Type>Business</t:Type></t:Value>
+      <t:Attributions><t:Attribution>0</t:Attribution></t:Attributions>
+    </t:PhoneNumberAttributedValue>
+    <t:PhoneNumberAttributedValue>
+      <t:Value><t:Number>+31 20 7654321</t:Number><t:Type>Business</t:Type></t:Value>
+      <t:Attributions><t:Attribution>1</t:Attribution></t:Attributions>
+    </t:PhoneNumberAttributedValue>
+  </t:BusinessPhoneNumbers>
+  <t:OfficeLocations>
+    <t:StringAttributedValue><t:Value>Amsterdam</t:Value><t:Attributions><t:Attribution>0</t:Attribution></t:Attributions></t:StringAttributedValue>
+    <t:StringAttributedValue><t:Value>Utrecht</t:Value><t:Attributions><t:Attribution>1</t:Attribution></t:Attributions></t:StringAttributedValue>
+  </t:OfficeLocations>
+  <t:ImAddresses>
+    <t:StringAttributedValue><t:Value>sip:jane@example.com</t:Value></t:StringAttributedValue>
+  </t:ImAddresses>
+  <t:HomeAddresses>
+    <t:PostalAddressAttributedValue>
+      <t:Value><t:Street>Main street 1</t:Street><t:City>Amsterdam</t:City><t:Type>Home</t:Type></t:Value>
+      <t:Attributions><t:Attribution>0</t:Attribution></t:Attributions>
+    </t:PostalAddressAttributedValue>
+  </t:HomeAddresses>
+</t:Persona>`
+
+	var p Persona
+	assert.NoError(t, xml.Unmarshal([]byte(data), &p))
+	assert.Equal(t, "Jane Doe", p.DisplayName)
+	assert.Equal(t, []Mailbox{
+		{Name: "Jane Doe", EmailAddress: "jane@example.com"},
+		{Name: "Jane", EmailAddress: "jane@example.org"},
+	}, p.EmailAddresses)
+	assert.Len(t, p.BusinessPhoneNumbers.PhoneNumberAttributedValue, 2)
+	assert.Equal(t, "+31 20 7654321", p.BusinessPhoneNumbers.PhoneNumberAttributedValue[1].Value.Number)
+	assert.Equal(t, []string{"1"}, p.BusinessPhoneNumbers.PhoneNumberAttributedValue[1].Attributions.Attribution)
+	assert.Equal(t, []string{"Amsterdam", "Utrecht"}, p.OfficeLocations.Values())
+	assert.Equal(t, []string{"sip:jane@example.com"}, p.ImAddresses.Values())
+	assert.Equal(t, "Main street 1", p.HomeAddresses.PostalAddressAttributedValue[0].Value.Street)
+	assert.Empty(t, p.Birthdays.Values())
+}
+
+func TestGetPersona(t *testing.T) {
+	x, err := xml.Marshal(GetPersona{PersonaId: PersonaId{Id: "AAUQAF"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:GetPersona><m:PersonaId Id="AAUQAF"></m:PersonaId></m:GetPersona>`, string(x))
+
+	const data = `<m:GetPersonaResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:Persona>
+    <t:PersonaId Id="AAUQAF" />
+    <t:DisplayName>Jane Doe</t:DisplayName>
+    <t:MobilePhones>
+      <t:PhoneNumberAttributedValue><t:Value><t:Number>+31 6 12345678</t:Number><t:Type>Mobile</t:Type></t:Value></t:PhoneNumberAttributedValue>
+    </t:MobilePhones>
+  </m:Persona>
+</m:GetPersonaResponseMessage>`
+
+	var msg GetPersonaResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Equal(t, ResponseClass_Success, msg.ResponseClass)
+	assert.Equal(t, "Jane Doe", msg.Persona.DisplayName)
+	assert.Equal(t, "+31 6 12345678", msg.Persona.MobilePhones.PhoneNumberAttributedValue[0].Value.Number)
+}
@@ -0,0 +1,49 @@
+package ewsxml
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+)
+
+// UserPhotoSize is the size of a user photo in pixels.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/sizerequested
+type UserPhotoSize string
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	UserPhotoSize_HR48x48   UserPhotoSize = "HR48x48"
+	UserPhotoSize_HR64x64   UserPhotoSize = "HR64x64"
+	UserPhotoSize_HR96x96   UserPhotoSize = "HR96x96"
+	UserPhotoSize_HR120x120 UserPhotoSize = "HR120x120"
+	UserPhotoSize_HR240x240 UserPhotoSize = "HR240x240"
+	UserPhotoSize_HR360x360 UserPhotoSize = "HR360x360"
+	UserPhotoSize_HR432x432 UserPhotoSize = "HR432x432"
+	UserPhotoSize_HR504x504 UserPhotoSize = "HR504x504"
+	UserPhotoSize_HR648x648 UserPhotoSize = "HR648x648"
+)
+
+// The GetUserPhoto element defines a request to get the photo of the user
+// with the Email address.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuserphoto
+type GetUserPhoto struct {
+	XMLName       xml.Name      `xml:"m:GetUserPhoto"`
+	Email         string        `xml:"m:Email"`
+	SizeRequested UserPhotoSize `xml:"m:SizeRequested"`
+}
+
+// The SetUserPhoto element defines a request to set the photo of the user
+// with the Email address. Content contains the base64 encoded image.
+type SetUserPhoto struct {
+	XMLName xml.Name `xml:"m:SetUserPhoto"`
+	Email   string   `xml:"m:Email"`
+	Content string   `xml:"m:Content"`
+}
+
+// NewSetUserPhoto creates a SetUserPhoto request with the base64 encoded
+// image data as its Content.
+func NewSetUserPhoto(email string, data []byte) SetUserPhoto {
+	return SetUserPhoto{
+		Email:   email,
+		Content: base64.StdEncoding.EncodeToString(data),
+	}
+}
@@ -0,0 +1,30 @@
+package ewsxml
+
+import (
+	"time"
+)
+
+// The PostItem element represents a post item in the Exchange store, which
+// are mostly found in public folders. ConversationIndex, ConversationTopic
+// and PostedTime are set by the server.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/postitem
+type PostItem struct {
+	MimeContent       *MimeContent       `xml:",omitempty"`
+	ItemId            *ItemId            `xml:",omitempty"`
+	ParentFolderId    *ItemId            `xml:",omitempty"`
+	ItemClass         string             `xml:",omitempty"`
+	Subject           string             `xml:",omitempty"`
+	Sensitivity       Sensitivity        `xml:",omitempty"`
+	Body              *Body              `xml:",omitempty"`
+	Attachments       *Attachments       `xml:",omitempty"`
+	HasAttachments    bool               `xml:",omitempty"`
+	ExtendedProperty  ExtendedProperties `xml:",omitempty"`
+	ConversationIndex string             `xml:",omitempty"`
+	ConversationTopic string             `xml:",omitempty"`
+	From              *Mailbox           `xml:"From>Mailbox,omitempty"`
+	InternetMessageId string             `xml:",omitempty"`
+	IsRead            bool               `xml:",omitempty"`
+	PostedTime        *time.Time         `xml:",omitempty"`
+	// References        string
+	Sender *Mailbox `xml:"Sender>Mailbox,omitempty"`
+}
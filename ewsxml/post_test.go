@@ -0,0 +1,54 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostItem(t *testing.T) {
+	x, err := xml.MarshalIndent(CreateItem{
+		SavedItemFolderId: SavedItemFolderId{FolderId: &FolderId{Id: "AQEuAAAD"}},
+		Items: Items{PostItem: []PostItem{{
+			Subject: "Welcome",
+			Body:    &Body{BodyType: BodyType_Text, Contents: []byte("Hello all")},
+		}}},
+	}, "", "  ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CreateItem>
+  <m:SavedItemFolderId>
+    <FolderId Id="AQEuAAAD"></FolderId>
+  </m:SavedItemFolderId>
+  <m:Items>
+    <PostItem>
+      <Subject>Welcome</Subject>
+      <Body BodyType="Text" IsTruncated="false">Hello all</Body>
+    </PostItem>
+  </m:Items>
+</m:CreateItem>`, string(x))
+
+	const data = `<m:Items xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:PostItem>
+    <t:ItemId Id="AAMkAD" ChangeKey="CQAAAB"/>
+    <t:Subject>Welcome</t:Subject>
+    <t:ConversationIndex>AdNrVw==</t:ConversationIndex>
+    <t:ConversationTopic>Welcome</t:ConversationTopic>
+    <t:From><t:Mailbox><t:Name>Jane</t:Name><t:EmailAddress>jane@example.com</t:EmailAddress></t:Mailbox></t:From>
+    <t:PostedTime>2024-03-01T09:00:00Z</t:PostedTime>
+  </t:PostItem>
+</m:Items>`
+
+	var items Items
+	assert.NoError(t, xml.Unmarshal([]byte(data), &items))
+	assert.Len(t, items.PostItem, 1)
+
+	post := items.PostItem[0]
+	assert.Equal(t, "AdNrVw==", post.ConversationIndex)
+	assert.Equal(t, &Mailbox{Name: "Jane", EmailAddress: "jane@example.com"}, post.From)
+	assert.Equal(t, time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC), *post.PostedTime)
+	assert.Equal(t, []ItemId{{Id: "AAMkAD", ChangeKey: "CQAAAB"}}, items.ItemIds())
+}
@@ -0,0 +1,205 @@
+package ewsxml
+
+import (
+	"strings"
+	"time"
+)
+
+// The DayOfWeek element represents a day of the week, or one of the
+// combined values Day, Weekday and WeekendDay.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/daysofweek
+type DayOfWeek string
+
+func (s DayOfWeek) String() string { return string(s) }
+
+// The DayOfWeekIndex element describes which week in a month is used in a
+// relative recurrence pattern.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/dayofweekindex
+type DayOfWeekIndex string
+
+func (s DayOfWeekIndex) String() string { return string(s) }
+
+// The Month element describes a month in a yearly recurrence pattern.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/month
+type Month string
+
+func (s Month) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	DayOfWeek_Sunday     DayOfWeek = "Sunday"
+	DayOfWeek_Monday     DayOfWeek = "Monday"
+	DayOfWeek_Tuesday    DayOfWeek = "Tuesday"
+	DayOfWeek_Wednesday  DayOfWeek = "Wednesday"
+	DayOfWeek_Thursday   DayOfWeek = "Thursday"
+	DayOfWeek_Friday     DayOfWeek = "Friday"
+	DayOfWeek_Saturday   DayOfWeek = "Saturday"
+	DayOfWeek_Day        DayOfWeek = "Day"
+	DayOfWeek_Weekday    DayOfWeek = "Weekday"
+	DayOfWeek_WeekendDay DayOfWeek = "WeekendDay"
+
+	DayOfWeekIndex_First  DayOfWeekIndex = "First"
+	DayOfWeekIndex_Second DayOfWeekIndex = "Second"
+	DayOfWeekIndex_Third  DayOfWeekIndex = "Third"
+	DayOfWeekIndex_Fourth DayOfWeekIndex = "Fourth"
+	DayOfWeekIndex_Last   DayOfWeekIndex = "Last"
+
+	Month_January   Month = "January"
+	Month_February  Month = "February"
+	Month_March     Month = "March"
+	Month_April     Month = "April"
+	Month_May       Month = "May"
+	Month_June      Month = "June"
+	Month_July      Month = "July"
+	Month_August    Month = "August"
+	Month_September Month = "September"
+	Month_October   Month = "October"
+	Month_November  Month = "November"
+	Month_December  Month = "December"
+)
+
+// DaysOfWeek is a space separated list of DayOfWeek values.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/daysofweek
+type DaysOfWeek string
+
+// NewDaysOfWeek returns a DaysOfWeek list containing days.
+func NewDaysOfWeek(days ...DayOfWeek) DaysOfWeek {
+	s := make([]string, len(days))
+	for i, d := range days {
+		s[i] = d.String()
+	}
+	return DaysOfWeek(strings.Join(s, " "))
+}
+
+// Days returns the separate DayOfWeek values of the list.
+func (d DaysOfWeek) Days() []DayOfWeek {
+	fields := strings.Fields(string(d))
+	res := make([]DayOfWeek, len(fields))
+	for i, f := range fields {
+		res[i] = DayOfWeek(f)
+	}
+	return res
+}
+
+func (d DaysOfWeek) String() string { return string(d) }
+
+// The Recurrence element contains the recurrence pattern and recurrence
+// range of a calendar item. Exactly one of the pattern fields and one of the
+// range fields should be set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/recurrence-recurrencetype
+type Recurrence struct {
+	RelativeYearlyRecurrence  *RelativeYearlyRecurrence  `xml:",omitempty"`
+	AbsoluteYearlyRecurrence  *AbsoluteYearlyRecurrence  `xml:",omitempty"`
+	RelativeMonthlyRecurrence *RelativeMonthlyRecurrence `xml:",omitempty"`
+	AbsoluteMonthlyRecurrence *AbsoluteMonthlyRecurrence `xml:",omitempty"`
+	WeeklyRecurrence          *WeeklyRecurrence          `xml:",omitempty"`
+	DailyRecurrence           *DailyRecurrence           `xml:",omitempty"`
+
+	NoEndRecurrence    *NoEndRecurrence    `xml:",omitempty"`
+	EndDateRecurrence  *EndDateRecurrence  `xml:",omitempty"`
+	NumberedRecurrence *NumberedRecurrence `xml:",omitempty"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/relativeyearlyrecurrence
+type RelativeYearlyRecurrence struct {
+	DaysOfWeek     DaysOfWeek
+	DayOfWeekIndex DayOfWeekIndex
+	Month          Month
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/absoluteyearlyrecurrence
+type AbsoluteYearlyRecurrence struct {
+	DayOfMonth int
+	Month      Month
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/relativemonthlyrecurrence
+type RelativeMonthlyRecurrence struct {
+	Interval       int
+	DaysOfWeek     DaysOfWeek
+	DayOfWeekIndex DayOfWeekIndex
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/absolutemonthlyrecurrence
+type AbsoluteMonthlyRecurrence struct {
+	Interval   int
+	DayOfMonth int
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/weeklyrecurrence
+type WeeklyRecurrence struct {
+	Interval       int
+	DaysOfWeek     DaysOfWeek
+	FirstDayOfWeek DayOfWeek `xml:",omitempty"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/dailyrecurrence
+type DailyRecurrence struct {
+	Interval int
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/noendrecurrence
+type NoEndRecurrence struct {
+	StartDate EwsDate
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/enddaterecurrence
+type EndDateRecurrence struct {
+	StartDate EwsDate
+	EndDate   EwsDate
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/numberedrecurrence
+type NumberedRecurrence struct {
+	StartDate           EwsDate
+	NumberOfOccurrences int
+}
+
+// The Recurrence element of a task contains its recurrence pattern and
+// recurrence range. Besides the patterns of Recurrence, a task can regenerate
+// a new task an Interval after the previous one is completed. Exactly one of
+// the pattern fields and one of the range fields should be set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/recurrence-taskrecurrencetype
+type TaskRecurrence struct {
+	RelativeYearlyRecurrence  *RelativeYearlyRecurrence  `xml:",omitempty"`
+	AbsoluteYearlyRecurrence  *AbsoluteYearlyRecurrence  `xml:",omitempty"`
+	RelativeMonthlyRecurrence *RelativeMonthlyRecurrence `xml:",omitempty"`
+	AbsoluteMonthlyRecurrence *AbsoluteMonthlyRecurrence `xml:",omitempty"`
+	WeeklyRecurrence          *WeeklyRecurrence          `xml:",omitempty"`
+	DailyRecurrence           *DailyRecurrence           `xml:",omitempty"`
+	DailyRegeneration         *Regeneration              `xml:",omitempty"`
+	WeeklyRegeneration        *Regeneration              `xml:",omitempty"`
+	MonthlyRegeneration       *Regeneration              `xml:",omitempty"`
+	YearlyRegeneration        *Regeneration              `xml:",omitempty"`
+
+	NoEndRecurrence    *NoEndRecurrence    `xml:",omitempty"`
+	EndDateRecurrence  *EndDateRecurrence  `xml:",omitempty"`
+	NumberedRecurrence *NumberedRecurrence `xml:",omitempty"`
+}
+
+// Regeneration is the pattern of a DailyRegeneration, WeeklyRegeneration,
+// MonthlyRegeneration or YearlyRegeneration.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/dailyregeneration
+type Regeneration struct {
+	Interval int
+}
+
+// OccurrenceInfo describes an occurrence of a recurring calendar item, such
+// as the FirstOccurrence, LastOccurrence and each of the ModifiedOccurrences
+// of a recurring master. Use the ItemId to get or update the occurrence, or
+// use an OccurrenceItemId or RecurringMasterItemId to refer to it by its
+// relation to the recurring master.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/occurrence
+type OccurrenceInfo struct {
+	ItemId        ItemId
+	Start         time.Time
+	End           time.Time
+	OriginalStart time.Time
+}
+
+// DeletedOccurrenceInfo describes a deleted occurrence of a recurring
+// calendar item. Start is the original start of the occurrence.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deletedoccurrence
+type DeletedOccurrenceInfo struct {
+	Start time.Time
+}
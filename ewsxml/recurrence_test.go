@@ -0,0 +1,56 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrence(t *testing.T) {
+	rec := Recurrence{
+		WeeklyRecurrence: &WeeklyRecurrence{
+			Interval:       1,
+			DaysOfWeek:     NewDaysOfWeek(DayOfWeek_Monday),
+			FirstDayOfWeek: DayOfWeek_Monday,
+		},
+		NumberedRecurrence: &NumberedRecurrence{
+			StartDate:           NewEwsDate(time.Date(2023, 6, 5, 0, 0, 0, 0, time.UTC)),
+			NumberOfOccurrences: 10,
+		},
+	}
+
+	x, err := xml.MarshalIndent(rec, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<Recurrence>
+  <WeeklyRecurrence>
+    <Interval>1</Interval>
+    <DaysOfWeek>Monday</DaysOfWeek>
+    <FirstDayOfWeek>Monday</FirstDayOfWeek>
+  </WeeklyRecurrence>
+  <NumberedRecurrence>
+    <StartDate>2023-06-05</StartDate>
+    <NumberOfOccurrences>10</NumberOfOccurrences>
+  </NumberedRecurrence>
+</Recurrence>`, string(x))
+
+	const data = `<t:Recurrence xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:RelativeMonthlyRecurrence>
+    <t:Interval>2</t:Interval>
+    <t:DaysOfWeek>Monday Friday</t:DaysOfWeek>
+    <t:DayOfWeekIndex>Last</t:DayOfWeekIndex>
+  </t:RelativeMonthlyRecurrence>
+  <t:EndDateRecurrence>
+    <t:StartDate>2023-06-05+02:00</t:StartDate>
+    <t:EndDate>2023-12-29+02:00</t:EndDate>
+  </t:EndDateRecurrence>
+</t:Recurrence>`
+
+	var have Recurrence
+	assert.NoError(t, xml.Unmarshal([]byte(data), &have))
+	assert.Equal(t, []DayOfWeek{DayOfWeek_Monday, DayOfWeek_Friday}, have.RelativeMonthlyRecurrence.DaysOfWeek.Days())
+	assert.Equal(t, DayOfWeekIndex_Last, have.RelativeMonthlyRecurrence.DayOfWeekIndex)
+	assert.Equal(t, "2023-12-29", have.EndDateRecurrence.EndDate.String())
+	assert.Nil(t, have.NumberedRecurrence)
+}
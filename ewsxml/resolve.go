@@ -12,6 +12,7 @@ type ContactDataShape string
 
 func (s ContactDataShape) String() string { return string(s) }
 
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
 const (
 	// SearchScope_ActiveDirectory indicates only the Active Directory
 	// directory service is searched.
@@ -30,22 +31,54 @@ const (
 
 	// ContactDataShape_IdOnly indicates the contact item identifier property
 	// is returned.
-	ContactDataShape_IdOnly
+	ContactDataShape_IdOnly ContactDataShape = "IdOnly"
 	// ContactDataShape_Default indicates the Default set of contact item
-	// properties is returned. For more information, see ResponseMessage shapes in EWS.
-	ContactDataShape_Default
+	// properties is returned.
+	ContactDataShape_Default ContactDataShape = "Default"
 	// ContactDataShape_AllProperties indicates the AllProperties set of
-	// contact item properties are returned.For more information, see ResponseMessage
-	// shapes in EWS.
-	ContactDataShape_AllProperties
+	// contact item properties are returned.
+	ContactDataShape_AllProperties ContactDataShape = "AllProperties"
 )
 
+// The ResolveNames element defines a request to resolve ambiguous names.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/resolvenames
 type ResolveNames struct {
-	XMLName               xml.Name         `xml:"ResolveNames"`
+	XMLName               xml.Name         `xml:"m:ResolveNames"`
 	ReturnFullContactData bool             `xml:",attr"`
-	SearchScope           SearchScope      `xml:",attr"`
-	ContactDataShape      ContactDataShape `xml:",attr"`
-	ParentFolderIds       []string         `xml:",omitempty"`
-	UnresolvedEntry       string           `xml:",omitempty"`
+	SearchScope           SearchScope      `xml:",attr,omitempty"`
+	ContactDataShape      ContactDataShape `xml:",attr,omitempty"`
+	ParentFolderIds       *FolderIds       `xml:"m:ParentFolderIds,omitempty"`
+	UnresolvedEntry       string           `xml:"m:UnresolvedEntry"`
+}
+
+// The ResolveNamesResponseMessage element contains the status and results of
+// a ResolveNames request. When the name matched more than one mailbox or
+// contact, ResponseClass is Warning with response code
+// ErrorNameResolutionMultipleResults and ResolutionSet contains each of the
+// candidates.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/resolvenamesresponsemessage
+type ResolveNamesResponseMessage struct {
+	ResponseMessage
+	ResolutionSet ResolutionSet
+}
+
+// Ambiguous indicates if the name resolved to more than one candidate.
+func (r *ResolveNamesResponseMessage) Ambiguous() bool {
+	return r.ResponseCode == ErrorNameResolutionMultipleResults || len(r.ResolutionSet.Resolution) > 1
+}
+
+// The ResolutionSet element contains an array of resolutions for an ambiguous
+// name.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/resolutionset
+type ResolutionSet struct {
+	TotalItemsInView        int  `xml:",attr"`
+	IncludesLastItemInRange bool `xml:",attr"`
+	Resolution              []Resolution
+}
+
+// The Resolution element contains a single resolved entity.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/resolution
+type Resolution struct {
+	Mailbox Mailbox
+	Contact *Contact `xml:",omitempty"`
 }
@@ -2,6 +2,9 @@ package ewsxml
 
 import (
 	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type ResponseClass string
@@ -24,7 +27,10 @@ const (
 
 type ResponseEnvelope struct {
 	XMLName xml.Name `xml:"Envelope"`
-	Body    struct {
+	Header  struct {
+		ServerVersionInfo *ServerVersionInfo
+	}
+	Body struct {
 		Response []byte `xml:",innerxml"`
 	}
 }
@@ -48,9 +54,35 @@ func (r *ResponseMessage) Response() *ResponseMessage { return r }
 
 func (r ResponseMessage) String() string { return r.MessageText }
 
+// The MessageXml element provides additional error response information.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/messagexml
 type MessageXml struct {
 	ExceptionType       string
 	ExceptionCode       string
 	ExceptionServerName string
 	ExceptionMessage    string
+	Value               []MessageXmlValue `xml:",omitempty"`
+}
+
+// MessageXmlValue is a named value, e.g. BackOffMilliseconds, which provides
+// additional information about an error.
+type MessageXmlValue struct {
+	Name  string `xml:",attr"`
+	Value string `xml:",chardata"`
+}
+
+// BackOff returns the duration the server asks the client to wait before
+// sending the next request, as indicated by a BackOffMilliseconds value.
+func (mx MessageXml) BackOff() (time.Duration, bool) {
+	for _, v := range mx.Value {
+		if v.Name != "BackOffMilliseconds" {
+			continue
+		}
+		ms, err := strconv.ParseInt(strings.TrimSpace(v.Value), 10, 64)
+		if err != nil || ms < 0 {
+			return 0, false
+		}
+		return time.Duration(ms) * time.Millisecond, true
+	}
+	return 0, false
 }
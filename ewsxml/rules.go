@@ -0,0 +1,182 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+// The GetInboxRules element defines a request to get the Inbox rules of the
+// mailbox identified by MailboxSmtpAddress, or of the caller's mailbox when
+// it is empty.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getinboxrules
+type GetInboxRules struct {
+	XMLName            xml.Name `xml:"m:GetInboxRules"`
+	MailboxSmtpAddress string   `xml:"m:MailboxSmtpAddress,omitempty"`
+}
+
+// The Rule element represents a single Inbox rule. Actions are performed on
+// items which match the Conditions, unless they also match the Exceptions.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/rule-ruletype
+type Rule struct {
+	RuleId         string `xml:",omitempty"`
+	DisplayName    string
+	Priority       int
+	IsEnabled      bool
+	IsNotSupported bool            `xml:",omitempty"`
+	IsInError      bool            `xml:",omitempty"`
+	Conditions     *RulePredicates `xml:",omitempty"`
+	Exceptions     *RulePredicates `xml:",omitempty"`
+	Actions        *RuleActions    `xml:",omitempty"`
+}
+
+// RulePredicates contains the conditions or exceptions of a Rule. An item
+// matches when it matches all of the set predicates.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/conditions
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/exceptions
+type RulePredicates struct {
+	Categories                   Categories     `xml:",omitempty"`
+	ContainsBodyStrings          Strings        `xml:",omitempty"`
+	ContainsHeaderStrings        Strings        `xml:",omitempty"`
+	ContainsRecipientStrings     Strings        `xml:",omitempty"`
+	ContainsSenderStrings        Strings        `xml:",omitempty"`
+	ContainsSubjectOrBodyStrings Strings        `xml:",omitempty"`
+	ContainsSubjectStrings       Strings        `xml:",omitempty"`
+	FromAddresses                EmailAddresses `xml:",omitempty"`
+	HasAttachments               bool           `xml:",omitempty"`
+	Importance                   Importance     `xml:",omitempty"`
+	IsMeetingRequest             bool           `xml:",omitempty"`
+	NotSentToMe                  bool           `xml:",omitempty"`
+	SentCcMe                     bool           `xml:",omitempty"`
+	SentOnlyToMe                 bool           `xml:",omitempty"`
+	SentToAddresses              EmailAddresses `xml:",omitempty"`
+	SentToMe                     bool           `xml:",omitempty"`
+	SentToOrCcMe                 bool           `xml:",omitempty"`
+	Sensitivity                  Sensitivity    `xml:",omitempty"`
+}
+
+// The Actions element contains the actions which are performed on items that
+// match a Rule.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/actions
+type RuleActions struct {
+	AssignCategories                Categories      `xml:",omitempty"`
+	CopyToFolder                    *TargetFolderId `xml:",omitempty"`
+	Delete                          bool            `xml:",omitempty"`
+	ForwardAsAttachmentToRecipients EmailAddresses  `xml:",omitempty"`
+	ForwardToRecipients             EmailAddresses  `xml:",omitempty"`
+	MarkImportance                  Importance      `xml:",omitempty"`
+	MarkAsRead                      bool            `xml:",omitempty"`
+	MoveToFolder                    *TargetFolderId `xml:",omitempty"`
+	PermanentDelete                 bool            `xml:",omitempty"`
+	RedirectToRecipients            EmailAddresses  `xml:",omitempty"`
+	StopProcessingRules             bool            `xml:",omitempty"`
+}
+
+// Strings contains a collection of strings a RulePredicates element matches
+// against.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/string
+type Strings struct {
+	String []string
+}
+
+func NewStrings(s ...string) Strings {
+	return Strings{String: s}
+}
+
+// MarshalXML omits the element when it does not contain any strings.
+func (s Strings) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(s.String) == 0 {
+		return nil
+	}
+
+	type strs Strings
+	return e.EncodeElement(strs(s), start)
+}
+
+// EmailAddresses contains a collection of e-mail addresses that are used by
+// RulePredicates and RuleActions.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/address-emailaddresstype
+type EmailAddresses struct {
+	Address []Mailbox
+}
+
+func NewEmailAddresses(mb ...Mailbox) EmailAddresses {
+	return EmailAddresses{Address: mb}
+}
+
+// MarshalXML omits the element when it does not contain any addresses.
+func (a EmailAddresses) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(a.Address) == 0 {
+		return nil
+	}
+
+	type addrs EmailAddresses
+	return e.EncodeElement(addrs(a), start)
+}
+
+// The UpdateInboxRules element defines a request to create, change and
+// delete Inbox rules of the mailbox identified by MailboxSmtpAddress, or of
+// the caller's mailbox when it is empty.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateinboxrules
+type UpdateInboxRules struct {
+	XMLName               xml.Name       `xml:"m:UpdateInboxRules"`
+	MailboxSmtpAddress    string         `xml:"m:MailboxSmtpAddress,omitempty"`
+	RemoveOutlookRuleBlob bool           `xml:"m:RemoveOutlookRuleBlob"`
+	Operations            RuleOperations `xml:"m:Operations"`
+}
+
+// RuleOperations contains the operations of an UpdateInboxRules request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/operations
+type RuleOperations struct {
+	CreateRuleOperation []CreateRuleOperation `xml:",omitempty"`
+	SetRuleOperation    []SetRuleOperation    `xml:",omitempty"`
+	DeleteRuleOperation []DeleteRuleOperation `xml:",omitempty"`
+}
+
+// Create adds a CreateRuleOperation which creates r.
+func (o *RuleOperations) Create(r Rule) *RuleOperations {
+	o.CreateRuleOperation = append(o.CreateRuleOperation, CreateRuleOperation{Rule: r})
+	return o
+}
+
+// Set adds a SetRuleOperation which replaces the existing rule with the same
+// RuleId as r.
+func (o *RuleOperations) Set(r Rule) *RuleOperations {
+	o.SetRuleOperation = append(o.SetRuleOperation, SetRuleOperation{Rule: r})
+	return o
+}
+
+// Delete adds a DeleteRuleOperation which deletes the rule with ruleId.
+func (o *RuleOperations) Delete(ruleId string) *RuleOperations {
+	o.DeleteRuleOperation = append(o.DeleteRuleOperation, DeleteRuleOperation{RuleId: ruleId})
+	return o
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createruleoperation
+type CreateRuleOperation struct {
+	Rule Rule
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/setruleoperation
+type SetRuleOperation struct {
+	Rule Rule
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteruleoperation
+type DeleteRuleOperation struct {
+	RuleId string
+}
+
+// The RuleOperationError element represents an error that occurred while
+// performing the operation with OperationIndex.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/ruleoperationerror
+type RuleOperationError struct {
+	OperationIndex   int
+	ValidationErrors []RuleValidationError `xml:"ValidationErrors>Error"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/error-rulevalidationerrortype
+type RuleValidationError struct {
+	FieldURI     string
+	ErrorCode    string
+	ErrorMessage string
+	FieldValue   string
+}
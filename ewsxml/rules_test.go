@@ -0,0 +1,89 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateInboxRules_MarshalXML(t *testing.T) {
+	var req UpdateInboxRules
+	req.Operations.
+		Create(Rule{
+			DisplayName: "Newsletters",
+			Priority:    1,
+			IsEnabled:   true,
+			Conditions: &RulePredicates{
+				ContainsSubjectStrings: NewStrings("newsletter"),
+				FromAddresses:          NewEmailAddresses(*EmailMailbox("news@example.com")),
+			},
+			Actions: &RuleActions{
+				MarkAsRead:   true,
+				MoveToFolder: &TargetFolderId{FolderId: &FolderId{Id: "AAMkAF"}},
+			},
+		}).
+		Delete("AQAAAA")
+
+	x, err := xml.MarshalIndent(req, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:UpdateInboxRules>
+  <m:RemoveOutlookRuleBlob>false</m:RemoveOutlookRuleBlob>
+  <m:Operations>
+    <CreateRuleOperation>
+      <Rule>
+        <DisplayName>Newsletters</DisplayName>
+        <Priority>1</Priority>
+        <IsEnabled>true</IsEnabled>
+        <Conditions>
+          <ContainsSubjectStrings>
+            <String>newsletter</String>
+          </ContainsSubjectStrings>
+          <FromAddresses>
+            <Address>
+              <EmailAddress>news@example.com</EmailAddress>
+            </Address>
+          </FromAddresses>
+        </Conditions>
+        <Actions>
+          <MarkAsRead>true</MarkAsRead>
+          <MoveToFolder>
+            <FolderId Id="AAMkAF"></FolderId>
+          </MoveToFolder>
+        </Actions>
+      </Rule>
+    </CreateRuleOperation>
+    <DeleteRuleOperation>
+      <RuleId>AQAAAA</RuleId>
+    </DeleteRuleOperation>
+  </m:Operations>
+</m:UpdateInboxRules>`, string(x))
+}
+
+func TestRule_UnmarshalXML(t *testing.T) {
+	const data = `<t:Rule xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:RuleId>AQAAAA</t:RuleId>
+  <t:DisplayName>Newsletters</t:DisplayName>
+  <t:Priority>1</t:Priority>
+  <t:IsEnabled>true</t:IsEnabled>
+  <t:Conditions>
+    <t:ContainsSubjectStrings><t:String>newsletter</t:String><t:String>digest</t:String></t:ContainsSubjectStrings>
+  </t:Conditions>
+  <t:Exceptions>
+    <t:FromAddresses><t:Address><t:Name>Boss</t:Name><t:EmailAddress>boss@example.com</t:EmailAddress></t:Address></t:FromAddresses>
+  </t:Exceptions>
+  <t:Actions>
+    <t:MoveToFolder><t:DistinguishedFolderId Id="junkemail" /></t:MoveToFolder>
+    <t:StopProcessingRules>true</t:StopProcessingRules>
+  </t:Actions>
+</t:Rule>`
+
+	var r Rule
+	assert.NoError(t, xml.Unmarshal([]byte(data), &r))
+	assert.Equal(t, "AQAAAA", r.RuleId)
+	assert.True(t, r.IsEnabled)
+	assert.Equal(t, []string{"newsletter", "digest"}, r.Conditions.ContainsSubjectStrings.String)
+	assert.Equal(t, []Mailbox{{Name: "Boss", EmailAddress: "boss@example.com"}}, r.Exceptions.FromAddresses.Address)
+	assert.Equal(t, DistinguishedFolderId_JunkEmail, r.Actions.MoveToFolder.DistinguishedFolderId.Id)
+	assert.True(t, r.Actions.StopProcessingRules)
+}
@@ -0,0 +1,274 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+)
+
+type SyncScope string
+
+func (s SyncScope) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	// SyncScope_NormalItems indicates only items in the folder are
+	// synchronized.
+	SyncScope_NormalItems SyncScope = "NormalItems"
+	// SyncScope_NormalAndAssociatedItems indicates both items and folder
+	// associated items are synchronized.
+	SyncScope_NormalAndAssociatedItems SyncScope = "NormalAndAssociatedItems"
+)
+
+// The SyncFolderItems element defines a request to synchronize items in an
+// Exchange store folder. SyncState should be left empty on the first request,
+// subsequent requests should contain the SyncState of the previous response.
+// The SyncState is opaque and should be stored as is.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/syncfolderitems
+type SyncFolderItems struct {
+	XMLName               xml.Name `xml:"m:SyncFolderItems"`
+	ItemShape             ItemShape
+	FolderId              *FolderId              `xml:"m:SyncFolderId>FolderId,omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:"m:SyncFolderId>DistinguishedFolderId,omitempty"`
+	SyncState             string                 `xml:"m:SyncState,omitempty"`
+	Ignore                *[]ItemId              `xml:"m:Ignore>ItemId,omitempty"`
+	MaxChangesReturned    int                    `xml:"m:MaxChangesReturned"`
+	SyncScope             SyncScope              `xml:"m:SyncScope,omitempty"`
+}
+
+// The SyncFolderItemsResponseMessage element contains the status and result
+// of a single SyncFolderItems operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/syncfolderitemsresponsemessage
+type SyncFolderItemsResponseMessage struct {
+	ResponseMessage
+	SyncState               string
+	IncludesLastItemInRange bool
+	Changes                 SyncFolderItemsChanges
+}
+
+// SyncChangeType is the name of a change element within the Changes of a
+// sync response.
+type SyncChangeType string
+
+func (s SyncChangeType) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	SyncChangeType_Create         SyncChangeType = "Create"
+	SyncChangeType_Update         SyncChangeType = "Update"
+	SyncChangeType_Delete         SyncChangeType = "Delete"
+	SyncChangeType_ReadFlagChange SyncChangeType = "ReadFlagChange"
+)
+
+// The SyncFolderItemsChanges element contains a sequence array of change
+// types that represent the types of differences between the items on the
+// client and the items on the computer that is running Exchange. The changes
+// are kept in the order in which the server returned them, which is the order
+// in which they should be applied.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/changes-items
+type SyncFolderItemsChanges []SyncFolderItemsChange
+
+func (c *SyncFolderItemsChanges) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return decodeSyncChanges(d, func(el *xml.StartElement) error {
+		change := SyncFolderItemsChange{ChangeType: SyncChangeType(el.Name.Local)}
+
+		var v interface{}
+		switch change.ChangeType {
+		case SyncChangeType_Create, SyncChangeType_Update:
+			change.Item = new(ItemSyncChange)
+			v = change.Item
+		case SyncChangeType_Delete:
+			change.Delete = new(ItemSyncDelete)
+			v = change.Delete
+		case SyncChangeType_ReadFlagChange:
+			change.ReadFlagChange = new(ItemReadFlagChange)
+			v = change.ReadFlagChange
+		default:
+			return d.Skip()
+		}
+
+		if err := d.DecodeElement(v, el); err != nil {
+			return err
+		}
+		*c = append(*c, change)
+		return nil
+	})
+}
+
+// decodeSyncChanges calls fn with each child element of the Changes element
+// until its end element is read.
+func decodeSyncChanges(d *xml.Decoder, fn func(el *xml.StartElement) error) error {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch el := tok.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			if err = fn(&el); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SyncFolderItemsChange is a single change within SyncFolderItemsChanges.
+// Which field is set depends on its ChangeType.
+type SyncFolderItemsChange struct {
+	ChangeType SyncChangeType
+	// Item is set when ChangeType is SyncChangeType_Create or
+	// SyncChangeType_Update.
+	Item *ItemSyncChange
+	// Delete is set when ChangeType is SyncChangeType_Delete.
+	Delete *ItemSyncDelete
+	// ReadFlagChange is set when ChangeType is
+	// SyncChangeType_ReadFlagChange.
+	ReadFlagChange *ItemReadFlagChange
+}
+
+// ItemId returns the ItemId of the item the change applies to.
+func (c *SyncFolderItemsChange) ItemId() ItemId {
+	switch {
+	case c.Item != nil:
+		return c.Item.ItemId()
+	case c.Delete != nil:
+		return c.Delete.ItemId
+	case c.ReadFlagChange != nil:
+		return c.ReadFlagChange.ItemId
+	}
+	return ItemId{}
+}
+
+// ItemSyncChange is the Create or Update element which contains the item that
+// is created or updated.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/create-itemsync
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/update-itemsync
+type ItemSyncChange struct {
+	Items
+}
+
+// ItemId returns the ItemId of the created or updated item.
+func (c *ItemSyncChange) ItemId() ItemId {
+	if ids := c.ItemIds(); len(ids) != 0 {
+		return ids[0]
+	}
+	return ItemId{}
+}
+
+// ItemSyncDelete is the Delete element which identifies a single item to
+// delete from the local client store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/delete-itemsync
+type ItemSyncDelete struct {
+	ItemId ItemId
+}
+
+// The ItemReadFlagChange element identifies items that have changed their read
+// status.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/readflagchange
+type ItemReadFlagChange struct {
+	ItemId ItemId
+	IsRead bool
+}
+
+// The SyncFolderHierarchy element defines a request to synchronize a folder
+// hierarchy on a client. When no sync folder is set the whole mailbox is
+// synchronized. Like with SyncFolderItems, the SyncState is opaque and should
+// be passed back as is.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/syncfolderhierarchy
+type SyncFolderHierarchy struct {
+	XMLName               xml.Name `xml:"m:SyncFolderHierarchy"`
+	FolderShape           FolderShape
+	FolderId              *FolderId              `xml:"m:SyncFolderId>FolderId,omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:"m:SyncFolderId>DistinguishedFolderId,omitempty"`
+	SyncState             string                 `xml:"m:SyncState,omitempty"`
+}
+
+// The SyncFolderHierarchyResponseMessage element contains the status and
+// result of a single SyncFolderHierarchy operation request.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/syncfolderhierarchyresponsemessage
+type SyncFolderHierarchyResponseMessage struct {
+	ResponseMessage
+	SyncState                 string
+	IncludesLastFolderInRange bool
+	Changes                   SyncFolderHierarchyChanges
+}
+
+// The SyncFolderHierarchyChanges element contains a sequence array of change
+// types that represent the types of differences between the folders on the
+// client and the folders on the computer that is running Exchange. Like
+// SyncFolderItemsChanges, the changes are kept in the order in which the
+// server returned them.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/changes-hierarchy
+type SyncFolderHierarchyChanges []SyncFolderHierarchyChange
+
+func (c *SyncFolderHierarchyChanges) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return decodeSyncChanges(d, func(el *xml.StartElement) error {
+		change := SyncFolderHierarchyChange{ChangeType: SyncChangeType(el.Name.Local)}
+
+		var v interface{}
+		switch change.ChangeType {
+		case SyncChangeType_Create, SyncChangeType_Update:
+			change.Folder = new(FolderSyncChange)
+			v = change.Folder
+		case SyncChangeType_Delete:
+			change.Delete = new(FolderSyncDelete)
+			v = change.Delete
+		default:
+			return d.Skip()
+		}
+
+		if err := d.DecodeElement(v, el); err != nil {
+			return err
+		}
+		*c = append(*c, change)
+		return nil
+	})
+}
+
+// SyncFolderHierarchyChange is a single change within
+// SyncFolderHierarchyChanges. Which field is set depends on its ChangeType.
+type SyncFolderHierarchyChange struct {
+	ChangeType SyncChangeType
+	// Folder is set when ChangeType is SyncChangeType_Create or
+	// SyncChangeType_Update.
+	Folder *FolderSyncChange
+	// Delete is set when ChangeType is SyncChangeType_Delete.
+	Delete *FolderSyncDelete
+}
+
+// FolderId returns the FolderId of the folder the change applies to.
+func (c *SyncFolderHierarchyChange) FolderId() FolderId {
+	switch {
+	case c.Folder != nil:
+		if f := c.Folder.Folder(); f.FolderId != nil {
+			return *f.FolderId
+		}
+	case c.Delete != nil:
+		return c.Delete.FolderId
+	}
+	return FolderId{}
+}
+
+// FolderSyncChange is the Create or Update element which contains the folder
+// that is created or updated.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/create-foldersync
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/update-foldersync
+type FolderSyncChange struct {
+	Folders
+}
+
+// Folder returns the created or updated folder.
+func (c *FolderSyncChange) Folder() Folder {
+	if all := c.All(); len(all) != 0 {
+		return all[0]
+	}
+	return Folder{}
+}
+
+// FolderSyncDelete is the Delete element which identifies a single folder to
+// delete from the local client store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/delete-foldersync
+type FolderSyncDelete struct {
+	FolderId FolderId
+}
@@ -0,0 +1,121 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncFolderItemsResponseMessage_UnmarshalXML(t *testing.T) {
+	const data = `<m:SyncFolderItemsResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:SyncState>H4sIAAAAAAAEAO29B2AcSZYlJi9/</m:SyncState>
+  <m:IncludesLastItemInRange>true</m:IncludesLastItemInRange>
+  <m:Changes>
+    <t:Create>
+      <t:Message>
+        <t:ItemId Id="AAAtAEFkbWluaX" ChangeKey="CQAAABYAAAB" />
+      </t:Message>
+    </t:Create>
+    <t:Delete>
+      <t:ItemId Id="AAAtAEFkbWluaZ" ChangeKey="CQAAABYAAAD" />
+    </t:Delete>
+    <t:ReadFlagChange>
+      <t:ItemId Id="AAAtAEFkbWluaY" ChangeKey="CQAAABYAAAC" />
+      <t:IsRead>true</t:IsRead>
+    </t:ReadFlagChange>
+    <t:Update>
+      <t:Message>
+        <t:ItemId Id="AAAtAEFkbWluaX" ChangeKey="CQAAABYAAAE" />
+      </t:Message>
+    </t:Update>
+    <t:Delete>
+      <t:ItemId Id="AAAtAEFkbWluaX" ChangeKey="CQAAABYAAAF" />
+    </t:Delete>
+  </m:Changes>
+</m:SyncFolderItemsResponseMessage>`
+
+	var msg SyncFolderItemsResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.Equal(t, "H4sIAAAAAAAEAO29B2AcSZYlJi9/", msg.SyncState)
+	assert.True(t, msg.IncludesLastItemInRange)
+
+	// changes are interleaved and must keep the order of the response
+	want := []struct {
+		typ SyncChangeType
+		id  ItemId
+	}{
+		{SyncChangeType_Create, ItemId{Id: "AAAtAEFkbWluaX", ChangeKey: "CQAAABYAAAB"}},
+		{SyncChangeType_Delete, ItemId{Id: "AAAtAEFkbWluaZ", ChangeKey: "CQAAABYAAAD"}},
+		{SyncChangeType_ReadFlagChange, ItemId{Id: "AAAtAEFkbWluaY", ChangeKey: "CQAAABYAAAC"}},
+		{SyncChangeType_Update, ItemId{Id: "AAAtAEFkbWluaX", ChangeKey: "CQAAABYAAAE"}},
+		{SyncChangeType_Delete, ItemId{Id: "AAAtAEFkbWluaX", ChangeKey: "CQAAABYAAAF"}},
+	}
+	if assert.Len(t, msg.Changes, len(want)) {
+		for i, w := range want {
+			assert.Equal(t, w.typ, msg.Changes[i].ChangeType)
+			assert.Equal(t, w.id, msg.Changes[i].ItemId())
+		}
+	}
+	assert.NotNil(t, msg.Changes[0].Item)
+	assert.Nil(t, msg.Changes[0].Delete)
+	assert.Equal(t, &ItemReadFlagChange{
+		ItemId: ItemId{Id: "AAAtAEFkbWluaY", ChangeKey: "CQAAABYAAAC"},
+		IsRead: true,
+	}, msg.Changes[2].ReadFlagChange)
+}
+
+func TestSyncFolderItems_MarshalXML(t *testing.T) {
+	const state = "H4sIAAAAAAAEAO29B2AcSZYlJi9/"
+
+	x, err := xml.Marshal(SyncFolderItems{
+		ItemShape:             ItemShape{BaseShape: BaseShape_IdOnly},
+		DistinguishedFolderId: new(DistinguishedFolderId).WithId(DistinguishedFolderId_Inbox),
+		SyncState:             state,
+		MaxChangesReturned:    10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:SyncFolderItems><m:ItemShape><BaseShape>IdOnly</BaseShape></m:ItemShape><m:SyncFolderId><DistinguishedFolderId Id="inbox"></DistinguishedFolderId></m:SyncFolderId><m:SyncState>`+state+`</m:SyncState><m:MaxChangesReturned>10</m:MaxChangesReturned></m:SyncFolderItems>`, string(x))
+}
+
+func TestSyncFolderHierarchyResponseMessage_UnmarshalXML(t *testing.T) {
+	const data = `<m:SyncFolderHierarchyResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:SyncState>H4sIAAAAAAAEAO29B2AcSZYlJi9/</m:SyncState>
+  <m:IncludesLastFolderInRange>true</m:IncludesLastFolderInRange>
+  <m:Changes>
+    <t:Delete>
+      <t:FolderId Id="AQAtAEFkbWluaB" ChangeKey="AQAAAA" />
+    </t:Delete>
+    <t:Create>
+      <t:Folder>
+        <t:FolderId Id="AQAtAEFkbWluaA" ChangeKey="AQAAAB" />
+        <t:DisplayName>Archive</t:DisplayName>
+      </t:Folder>
+    </t:Create>
+    <t:Update>
+      <t:CalendarFolder>
+        <t:FolderId Id="AQAtAEFkbWluaC" ChangeKey="AQAAAC" />
+      </t:CalendarFolder>
+    </t:Update>
+  </m:Changes>
+</m:SyncFolderHierarchyResponseMessage>`
+
+	var msg SyncFolderHierarchyResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+	assert.True(t, msg.IncludesLastFolderInRange)
+
+	if assert.Len(t, msg.Changes, 3) {
+		assert.Equal(t, SyncChangeType_Delete, msg.Changes[0].ChangeType)
+		assert.Equal(t, FolderId{Id: "AQAtAEFkbWluaB", ChangeKey: "AQAAAA"}, msg.Changes[0].FolderId())
+		assert.Equal(t, SyncChangeType_Create, msg.Changes[1].ChangeType)
+		assert.Equal(t, "Archive", msg.Changes[1].Folder.Folder().DisplayName)
+		assert.Equal(t, SyncChangeType_Update, msg.Changes[2].ChangeType)
+		assert.Equal(t, FolderId{Id: "AQAtAEFkbWluaC", ChangeKey: "AQAAAC"}, msg.Changes[2].FolderId())
+	}
+}
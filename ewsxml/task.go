@@ -0,0 +1,67 @@
+package ewsxml
+
+import (
+	"time"
+)
+
+// The Status element represents the status of a task item.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/status
+type TaskStatus string
+
+func (s TaskStatus) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	TaskStatus_NotStarted      TaskStatus = "NotStarted"
+	TaskStatus_InProgress      TaskStatus = "InProgress"
+	TaskStatus_Completed       TaskStatus = "Completed"
+	TaskStatus_WaitingOnOthers TaskStatus = "WaitingOnOthers"
+	TaskStatus_Deferred        TaskStatus = "Deferred"
+)
+
+// The Task element represents a task in the Exchange store.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/task
+type Task struct {
+	MimeContent                *MimeContent       `xml:",omitempty"`
+	ItemId                     *ItemId            `xml:",omitempty"`
+	ParentFolderId             *ItemId            `xml:",omitempty"`
+	ItemClass                  string             `xml:",omitempty"`
+	Subject                    string             `xml:",omitempty"`
+	Sensitivity                Sensitivity        `xml:",omitempty"`
+	Body                       *Body              `xml:",omitempty"`
+	Attachments                *Attachments       `xml:",omitempty"`
+	ReminderDueBy              EwsDateTime        `xml:",omitempty"`
+	ReminderIsSet              bool               `xml:",omitempty"`
+	ReminderMinutesBeforeStart Minutes            `xml:",omitempty"`
+	HasAttachments             bool               `xml:",omitempty"`
+	ExtendedProperty           ExtendedProperties `xml:",omitempty"`
+	Flag                       *Flag              `xml:",omitempty"`
+	// ActualWork           string
+	// AssignedTime         string
+	// BillingInformation   string
+	// ChangeCount          string
+	// Companies            string
+	CompleteDate *time.Time `xml:",omitempty"`
+	// Contacts             string
+	// DelegationState      string
+	// Delegator            string
+	DueDate *time.Time `xml:",omitempty"`
+	// IsAssignmentEditable string
+	IsComplete bool `xml:",omitempty"`
+	// IsRecurring          string
+	// IsTeamTask           string
+	// Mileage              string
+	Owner           string          `xml:",omitempty"`
+	PercentComplete float64         `xml:",omitempty"`
+	Recurrence      *TaskRecurrence `xml:",omitempty"`
+	StartDate       *time.Time      `xml:",omitempty"`
+	Status          TaskStatus      `xml:",omitempty"`
+	// StatusDescription    string
+	// TotalWork            string
+}
+
+// SetReminder sets a reminder which is due at t.
+func (t *Task) SetReminder(at time.Time) {
+	t.ReminderIsSet = true
+	t.ReminderDueBy = NewEwsDateTime(at)
+}
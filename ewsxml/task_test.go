@@ -0,0 +1,77 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTask_SetReminder(t *testing.T) {
+	task := Task{Subject: "Call back", ReminderMinutesBeforeStart: Minutes(30 * time.Minute)}
+	task.SetReminder(time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+
+	have, err := xml.MarshalIndent(task, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<Task>
+  <Subject>Call back</Subject>
+  <ReminderDueBy>2024-03-01T09:00:00Z</ReminderDueBy>
+  <ReminderIsSet>true</ReminderIsSet>
+  <ReminderMinutesBeforeStart>30</ReminderMinutesBeforeStart>
+</Task>`, string(have))
+}
+
+func TestCreateItem_Task(t *testing.T) {
+	due := time.Date(2024, 3, 8, 17, 0, 0, 0, time.UTC)
+	x, err := xml.MarshalIndent(CreateItem{Items: Items{Task: []Task{{
+		Subject: "Submit timesheet",
+		DueDate: &due,
+		Status:  TaskStatus_NotStarted,
+		Recurrence: &TaskRecurrence{
+			WeeklyRegeneration: &Regeneration{Interval: 1},
+			NoEndRecurrence:    &NoEndRecurrence{StartDate: NewEwsDate(due)},
+		},
+	}}}}, "", "  ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CreateItem>
+  <m:Items>
+    <Task>
+      <Subject>Submit timesheet</Subject>
+      <DueDate>2024-03-08T17:00:00Z</DueDate>
+      <Recurrence>
+        <WeeklyRegeneration>
+          <Interval>1</Interval>
+        </WeeklyRegeneration>
+        <NoEndRecurrence>
+          <StartDate>2024-03-08</StartDate>
+        </NoEndRecurrence>
+      </Recurrence>
+      <Status>NotStarted</Status>
+    </Task>
+  </m:Items>
+</m:CreateItem>`, string(x))
+}
+
+func TestUpdates_CompleteTask(t *testing.T) {
+	var u Updates
+	u.CompleteTask(time.Date(2024, 3, 8, 16, 30, 0, 0, time.UTC))
+
+	x, err := xml.MarshalIndent(u, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<Updates>
+  <SetItemField>
+    <FieldURI FieldURI="task:Status"></FieldURI>
+    <Task>
+      <Status>Completed</Status>
+    </Task>
+  </SetItemField>
+  <SetItemField>
+    <FieldURI FieldURI="task:CompleteDate"></FieldURI>
+    <Task>
+      <CompleteDate>2024-03-08T16:30:00Z</CompleteDate>
+    </Task>
+  </SetItemField>
+</Updates>`, string(x))
+}
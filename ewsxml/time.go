@@ -3,16 +3,27 @@ package ewsxml
 import (
 	"encoding/xml"
 	"fmt"
+	"strings"
 	"time"
 )
 
+// The DateTimePrecision element specifies the resolution of date/time values
+// in responses.
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/datetimeprecision
 type DateTimePrecision string
 
 func (d DateTimePrecision) String() string { return string(d) }
 
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
 const (
-	DateTimePrecision_Seconds      DateTimePrecision = "Seconds"
+	// DateTimePrecision_Default omits the DateTimePrecision header, so the
+	// server's default precision, which is seconds, is used.
+	DateTimePrecision_Default DateTimePrecision = ""
+	// DateTimePrecision_Seconds returns date/time values with a precision of
+	// seconds.
+	DateTimePrecision_Seconds DateTimePrecision = "Seconds"
+	// DateTimePrecision_Milliseconds returns date/time values with a
+	// precision of milliseconds.
 	DateTimePrecision_Milliseconds DateTimePrecision = "Milliseconds"
 )
 
@@ -43,11 +54,189 @@ type TimeZoneContext struct {
 
 // https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/timezonedefinition
 type TimeZoneDefinition struct {
-	Id   TimeZoneId `xml:",attr,omitempty"`
-	Name string     `xml:",attr,omitempty"`
-	// Periods
-	// TransitionsGroups
-	// Transitions
+	Id                TimeZoneId          `xml:",attr,omitempty"`
+	Name              string              `xml:",attr,omitempty"`
+	Periods           *[]Period           `xml:"Periods>Period,omitempty"`
+	TransitionsGroups *[]TransitionsGroup `xml:"TransitionsGroups>TransitionsGroup,omitempty"`
+	Transitions       *Transitions        `xml:",omitempty"`
+}
+
+// The Period element defines the name, bias and identifier of a time period
+// of a time zone. Bias is the offset from UTC formatted as xs:duration, for
+// example "-PT8H".
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/period
+type Period struct {
+	Bias string `xml:",attr"`
+	Name string `xml:",attr"`
+	Id   string `xml:",attr"`
+}
+
+// The TransitionsGroup element defines a group of time zone transitions.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/transitionsgroup
+type TransitionsGroup struct {
+	Id string `xml:",attr"`
+	Transitions
+}
+
+// The Transitions element contains the time zone transitions which are
+// applied to a period, or the transitions between transitions groups.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/transitions
+type Transitions struct {
+	Transition              []Transition              `xml:",omitempty"`
+	AbsoluteDateTransition  []AbsoluteDateTransition  `xml:",omitempty"`
+	RecurringDayTransition  []RecurringDayTransition  `xml:",omitempty"`
+	RecurringDateTransition []RecurringDateTransition `xml:",omitempty"`
+}
+
+type TransitionTargetKind string
+
+func (s TransitionTargetKind) String() string { return string(s) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	TransitionTargetKind_Period TransitionTargetKind = "Period"
+	TransitionTargetKind_Group  TransitionTargetKind = "Group"
+)
+
+// The To element specifies the period or transitions group that is the target
+// of a time zone transition.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/to-transitiontargettype
+type TransitionTarget struct {
+	Kind  TransitionTargetKind `xml:",attr"`
+	Value string               `xml:",chardata"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/transition
+type Transition struct {
+	To TransitionTarget
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/absolutedatetransition
+type AbsoluteDateTransition struct {
+	To       TransitionTarget
+	DateTime string
+}
+
+// The RecurringDayTransition element defines a transition which occurs on a
+// day of the week in a month, for example the last Sunday of March.
+// Occurrence -1 indicates the last occurrence of DayOfWeek in Month.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/recurringdaytransition
+type RecurringDayTransition struct {
+	To         TransitionTarget
+	TimeOffset string
+	Month      int
+	DayOfWeek  string
+	Occurrence int
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/recurringdatetransition
+type RecurringDateTransition struct {
+	To         TransitionTarget
+	TimeOffset string
+	Month      int
+	Day        int
+}
+
+// DateTimeFormat is the layout of UTC date times in EWS requests.
+const DateTimeFormat = "2006-01-02T15:04:05Z"
+
+// EwsDateTime is a time.Time which is marshaled as an EWS compliant UTC date
+// time, e.g. 2006-01-02T15:04:05Z. When unmarshaling, date times with an
+// offset, without any zone, which are considered UTC, and plain dates are
+// accepted. A zero EwsDateTime is omitted from the output, both as element
+// and as attribute.
+type EwsDateTime struct {
+	time.Time
+}
+
+// NewEwsDateTime returns t as EwsDateTime.
+func NewEwsDateTime(t time.Time) EwsDateTime { return EwsDateTime{Time: t} }
+
+var ewsDateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02Z07:00",
+	"2006-01-02",
+}
+
+// String returns the date time formatted according to DateTimeFormat.
+func (dt EwsDateTime) String() string {
+	return dt.UTC().Format(DateTimeFormat)
+}
+
+func (dt EwsDateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if dt.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(dt.String(), start)
+}
+
+func (dt *EwsDateTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return dt.parse(s)
+}
+
+func (dt EwsDateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if dt.IsZero() {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: dt.String()}, nil
+}
+
+func (dt *EwsDateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return dt.parse(attr.Value)
+}
+
+func (dt *EwsDateTime) parse(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		dt.Time = time.Time{}
+		return nil
+	}
+
+	var err error
+	for _, layout := range ewsDateTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			dt.Time = t
+			return nil
+		}
+	}
+	return err
+}
+
+// DateFormat is the layout of dates in EWS requests.
+const DateFormat = "2006-01-02"
+
+// EwsDate is a time.Time which is marshaled as an EWS compliant date, e.g.
+// 2006-01-02. It accepts the same formats as EwsDateTime when unmarshaling.
+type EwsDate struct {
+	time.Time
+}
+
+// NewEwsDate returns the date of t as EwsDate.
+func NewEwsDate(t time.Time) EwsDate { return EwsDate{Time: t} }
+
+// String returns the date formatted according to DateFormat.
+func (d EwsDate) String() string { return d.Format(DateFormat) }
+
+func (d EwsDate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if d.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(d.String(), start)
+}
+
+func (d *EwsDate) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var dt EwsDateTime
+	if err := dec.DecodeElement(&dt, &start); err != nil {
+		return err
+	}
+	d.Time = dt.Time
+	return nil
 }
 
 type TimeZoneId string
@@ -79,37 +268,21 @@ func getRFC3339Offset(t time.Time) (string, error) {
 	return fmt.Sprintf("%s%02d:%02d", sign, hour, min), nil
 }
 
+// Minutes is a time.Duration which is marshaled as a whole number of
+// minutes, e.g. 15.
 type Minutes time.Duration
 
 func (m Minutes) Duration() time.Duration { return time.Duration(m) }
 
 func (m Minutes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.EncodeElement(m.Duration()/time.Minute, start)
-}
-
-// func (m *Minutes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-// 	var v interface{}
-// 	if err := d.DecodeElement(&v, &start); err != nil {
-// 		return err
-// 	}
-//
-// 	switch v := v.(type) {
-// 	case string:
-// 		dur, err := time.ParseDuration(v)
-// 		if err == nil {
-// 			*m = Minutes(dur)
-// 			return nil
-// 		}
-// 		if int, err2 := strconv.Atoi(v); err2 == nil {
-// 			*m = Minutes(time.Duration(int) * time.Minute)
-// 		}
-// 			return err
-// 		}
-//
-// 		m.Duration = dur
-// 		return nil
-// 	case int64:
-// 		m.Duration = time.Duration(v) * time.Minute
-// 	}
-// 	return nil
-// }
+	return e.EncodeElement(int64(m.Duration()/time.Minute), start)
+}
+
+func (m *Minutes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v int64
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*m = Minutes(time.Duration(v) * time.Minute)
+	return nil
+}
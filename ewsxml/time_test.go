@@ -1,9 +1,12 @@
 package ewsxml
 
 import (
+	"encoding/xml"
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_getRFC3339Offset(t *testing.T) {
@@ -46,3 +49,101 @@ func Test_getRFC3339Offset(t *testing.T) {
 	}
 
 }
+
+func TestGetServerTimeZonesResponseMessage(t *testing.T) {
+	const data = `<m:GetServerTimeZonesResponseMessage ResponseClass="Success">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:TimeZoneDefinitions>
+    <t:TimeZoneDefinition Id="Pacific Standard Time" Name="(UTC-08:00) Pacific Time (US &amp; Canada)">
+      <t:Periods>
+        <t:Period Bias="PT8H" Name="Standard" Id="trule:Microsoft/Registry/Pacific Standard Time/2006-Standard" />
+        <t:Period Bias="PT7H" Name="Daylight" Id="trule:Microsoft/Registry/Pacific Standard Time/2006-Daylight" />
+      </t:Periods>
+      <t:TransitionsGroups>
+        <t:TransitionsGroup Id="0">
+          <t:RecurringDayTransition>
+            <t:To Kind="Period">trule:Microsoft/Registry/Pacific Standard Time/2006-Daylight</t:To>
+            <t:TimeOffset>PT2H</t:TimeOffset>
+            <t:Month>4</t:Month>
+            <t:DayOfWeek>Sunday</t:DayOfWeek>
+            <t:Occurrence>1</t:Occurrence>
+          </t:RecurringDayTransition>
+          <t:RecurringDayTransition>
+            <t:To Kind="Period">trule:Microsoft/Registry/Pacific Standard Time/2006-Standard</t:To>
+            <t:TimeOffset>PT2H</t:TimeOffset>
+            <t:Month>10</t:Month>
+            <t:DayOfWeek>Sunday</t:DayOfWeek>
+            <t:Occurrence>-1</t:Occurrence>
+          </t:RecurringDayTransition>
+        </t:TransitionsGroup>
+      </t:TransitionsGroups>
+      <t:Transitions>
+        <t:Transition>
+          <t:To Kind="Group">0</t:To>
+        </t:Transition>
+      </t:Transitions>
+    </t:TimeZoneDefinition>
+  </m:TimeZoneDefinitions>
+</m:GetServerTimeZonesResponseMessage>`
+
+	var have GetServerTimeZonesResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &have))
+	assert.Len(t, have.TimeZoneDefinitions, 1)
+
+	tz := have.TimeZoneDefinitions[0]
+	assert.Equal(t, TimeZoneId("Pacific Standard Time"), tz.Id)
+	assert.Len(t, *tz.Periods, 2)
+	assert.Equal(t, "PT8H", (*tz.Periods)[0].Bias)
+	assert.Len(t, *tz.TransitionsGroups, 1)
+
+	rdt := (*tz.TransitionsGroups)[0].RecurringDayTransition
+	assert.Len(t, rdt, 2)
+	assert.Equal(t, -1, rdt[1].Occurrence)
+	assert.Equal(t, TransitionTarget{Kind: TransitionTargetKind_Group, Value: "0"}, tz.Transitions.Transition[0].To)
+}
+
+func TestTimeZoneContext(t *testing.T) {
+	x, err := xml.Marshal(TimeZoneContext{TimeZoneDefinition: TimeZoneDefinition{Id: "W. Europe Standard Time"}})
+	assert.NoError(t, err)
+	assert.Equal(t, `<TimeZoneContext><TimeZoneDefinition Id="W. Europe Standard Time"></TimeZoneDefinition></TimeZoneContext>`, string(x))
+}
+
+func TestEwsDateTime(t *testing.T) {
+	type item struct {
+		XMLName xml.Name    `xml:"Item"`
+		Attr    EwsDateTime `xml:",attr"`
+		Start   EwsDateTime
+		End     EwsDateTime
+	}
+
+	loc := time.FixedZone("", -5*60*60)
+	have, err := xml.Marshal(item{
+		Attr:  NewEwsDateTime(time.Date(2023, 6, 1, 9, 0, 0, 0, loc)),
+		Start: NewEwsDateTime(time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<Item Attr="2023-06-01T14:00:00Z"><Start>2023-06-01T09:00:00Z</Start></Item>`, string(have))
+
+	tests := map[string]time.Time{
+		"2023-06-01T09:00:00Z":      time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC),
+		"2023-06-01T09:00:00.5Z":    time.Date(2023, 6, 1, 9, 0, 0, 5e8, time.UTC),
+		"2023-06-01T09:00:00.123Z":  time.Date(2023, 6, 1, 9, 0, 0, 123e6, time.UTC),
+		"2023-06-01T09:00:00.123":   time.Date(2023, 6, 1, 9, 0, 0, 123e6, time.UTC),
+		"2023-06-01T09:00:00-05:00": time.Date(2023, 6, 1, 14, 0, 0, 0, time.UTC),
+		"2023-06-01T09:00:00":       time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC),
+		"2023-06-01":                time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		"2023-06-01+02:00":          time.Date(2023, 5, 31, 22, 0, 0, 0, time.UTC),
+	}
+	for in, want := range tests {
+		t.Run(in, func(t *testing.T) {
+			var it item
+			assert.NoError(t, xml.Unmarshal([]byte(`<Item Attr="`+in+`"><Start>`+in+`</Start></Item>`), &it))
+			assert.True(t, want.Equal(it.Attr.Time), it.Attr.String())
+			assert.True(t, want.Equal(it.Start.Time), it.Start.String())
+			assert.True(t, it.End.IsZero())
+		})
+	}
+
+	var it item
+	assert.Error(t, xml.Unmarshal([]byte(`<Item><Start>tomorrow</Start></Item>`), &it))
+}
@@ -0,0 +1,210 @@
+package ewsxml
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"strings"
+)
+
+// UserConfigurationProperty identifies a part of a user configuration object.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/userconfigurationproperties
+type UserConfigurationProperty string
+
+func (p UserConfigurationProperty) String() string { return string(p) }
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	UserConfigurationProperty_Id         UserConfigurationProperty = "Id"
+	UserConfigurationProperty_Dictionary UserConfigurationProperty = "Dictionary"
+	UserConfigurationProperty_XmlData    UserConfigurationProperty = "XmlData"
+	UserConfigurationProperty_BinaryData UserConfigurationProperty = "BinaryData"
+	UserConfigurationProperty_All        UserConfigurationProperty = "All"
+)
+
+// UserConfigurationProperties is a list of UserConfigurationProperty values,
+// which is marshaled as a space separated string.
+type UserConfigurationProperties []UserConfigurationProperty
+
+func (p UserConfigurationProperties) MarshalText() ([]byte, error) {
+	s := make([]string, len(p))
+	for i, v := range p {
+		s[i] = string(v)
+	}
+	return []byte(strings.Join(s, " ")), nil
+}
+
+func (p *UserConfigurationProperties) UnmarshalText(text []byte) error {
+	*p = (*p)[:0]
+	for _, v := range strings.Fields(string(text)) {
+		*p = append(*p, UserConfigurationProperty(v))
+	}
+	return nil
+}
+
+// DictionaryObjectType is the type of a key or value of a user configuration
+// dictionary.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/type-userconfigurationdictionaryobjecttypestype
+type DictionaryObjectType string
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	DictionaryObjectType_Boolean           DictionaryObjectType = "Boolean"
+	DictionaryObjectType_Byte              DictionaryObjectType = "Byte"
+	DictionaryObjectType_ByteArray         DictionaryObjectType = "ByteArray"
+	DictionaryObjectType_DateTime          DictionaryObjectType = "DateTime"
+	DictionaryObjectType_Integer32         DictionaryObjectType = "Integer32"
+	DictionaryObjectType_UnsignedInteger32 DictionaryObjectType = "UnsignedInteger32"
+	DictionaryObjectType_Integer64         DictionaryObjectType = "Integer64"
+	DictionaryObjectType_UnsignedInteger64 DictionaryObjectType = "UnsignedInteger64"
+	DictionaryObjectType_String            DictionaryObjectType = "String"
+	DictionaryObjectType_StringArray       DictionaryObjectType = "StringArray"
+)
+
+// The UserConfigurationName element identifies a user configuration object
+// by its Name and the folder it is stored in. Only one of FolderId and
+// DistinguishedFolderId should be set.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/userconfigurationname
+type UserConfigurationName struct {
+	Name                  string                 `xml:",attr"`
+	FolderId              *FolderId              `xml:",omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:",omitempty"`
+}
+
+// The UserConfiguration element represents a user configuration object, which
+// stores application settings in a mailbox folder. XmlData and BinaryData
+// contain base64 encoded data.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/userconfiguration
+type UserConfiguration struct {
+	UserConfigurationName UserConfigurationName
+	ItemId                *ItemId                      `xml:",omitempty"`
+	Dictionary            *UserConfigurationDictionary `xml:",omitempty"`
+	XmlData               string                       `xml:",omitempty"`
+	BinaryData            string                       `xml:",omitempty"`
+}
+
+// SetXmlData sets the base64 encoded data as XmlData.
+func (uc *UserConfiguration) SetXmlData(data []byte) {
+	uc.XmlData = base64.StdEncoding.EncodeToString(data)
+}
+
+// XmlDataBytes returns the decoded XmlData.
+func (uc UserConfiguration) XmlDataBytes() ([]byte, error) {
+	if uc.XmlData == "" {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(uc.XmlData)
+}
+
+// SetBinaryData sets the base64 encoded data as BinaryData.
+func (uc *UserConfiguration) SetBinaryData(data []byte) {
+	uc.BinaryData = base64.StdEncoding.EncodeToString(data)
+}
+
+// BinaryDataBytes returns the decoded BinaryData.
+func (uc UserConfiguration) BinaryDataBytes() ([]byte, error) {
+	if uc.BinaryData == "" {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(uc.BinaryData)
+}
+
+// The Dictionary element contains the key/value pairs of a user configuration
+// object.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/dictionary
+type UserConfigurationDictionary struct {
+	DictionaryEntry []DictionaryEntry
+}
+
+// Set adds a string entry with key and value, or replaces the value of an
+// existing entry with the same string key.
+func (d *UserConfigurationDictionary) Set(key, value string) *UserConfigurationDictionary {
+	v := DictionaryObject{Type: DictionaryObjectType_String, Value: []string{value}}
+	for i, e := range d.DictionaryEntry {
+		if e.DictionaryKey.string() == key {
+			d.DictionaryEntry[i].DictionaryValue = &v
+			return d
+		}
+	}
+
+	d.DictionaryEntry = append(d.DictionaryEntry, DictionaryEntry{
+		DictionaryKey:   DictionaryObject{Type: DictionaryObjectType_String, Value: []string{key}},
+		DictionaryValue: &v,
+	})
+	return d
+}
+
+// Get returns the first value of the entry with the string key.
+func (d *UserConfigurationDictionary) Get(key string) (string, bool) {
+	for _, e := range d.DictionaryEntry {
+		if e.DictionaryKey.string() == key {
+			if e.DictionaryValue == nil || len(e.DictionaryValue.Value) == 0 {
+				return "", true
+			}
+			return e.DictionaryValue.Value[0], true
+		}
+	}
+	return "", false
+}
+
+// The DictionaryEntry element represents a single key/value pair. A nil
+// DictionaryValue represents a null value.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/dictionaryentry
+type DictionaryEntry struct {
+	DictionaryKey   DictionaryObject
+	DictionaryValue *DictionaryObject `xml:",omitempty"`
+}
+
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/dictionarykey
+type DictionaryObject struct {
+	Type  DictionaryObjectType
+	Value []string
+}
+
+func (o DictionaryObject) string() string {
+	if o.Type != DictionaryObjectType_String || len(o.Value) == 0 {
+		return ""
+	}
+	return o.Value[0]
+}
+
+// The GetUserConfiguration element defines a request to get a user
+// configuration object. UserConfigurationProperties determines which parts of
+// the object are returned.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuserconfiguration
+type GetUserConfiguration struct {
+	XMLName                     xml.Name                    `xml:"m:GetUserConfiguration"`
+	UserConfigurationName       UserConfigurationName       `xml:"m:UserConfigurationName"`
+	UserConfigurationProperties UserConfigurationProperties `xml:"m:UserConfigurationProperties"`
+}
+
+// The GetUserConfigurationResponseMessage element contains the status and
+// the requested user configuration object.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/getuserconfigurationresponsemessage
+type GetUserConfigurationResponseMessage struct {
+	ResponseMessage
+	UserConfiguration UserConfiguration
+}
+
+// The CreateUserConfiguration element defines a request to create a user
+// configuration object.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/createuserconfiguration
+type CreateUserConfiguration struct {
+	XMLName           xml.Name          `xml:"m:CreateUserConfiguration"`
+	UserConfiguration UserConfiguration `xml:"m:UserConfiguration"`
+}
+
+// The UpdateUserConfiguration element defines a request to update a user
+// configuration object. Only the parts which are set are replaced.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateuserconfiguration
+type UpdateUserConfiguration struct {
+	XMLName           xml.Name          `xml:"m:UpdateUserConfiguration"`
+	UserConfiguration UserConfiguration `xml:"m:UserConfiguration"`
+}
+
+// The DeleteUserConfiguration element defines a request to delete a user
+// configuration object.
+// https://learn.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteuserconfiguration
+type DeleteUserConfiguration struct {
+	XMLName               xml.Name              `xml:"m:DeleteUserConfiguration"`
+	UserConfigurationName UserConfigurationName `xml:"m:UserConfigurationName"`
+}
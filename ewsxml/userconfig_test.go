@@ -0,0 +1,101 @@
+package ewsxml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateUserConfiguration_MarshalXML(t *testing.T) {
+	req := CreateUserConfiguration{UserConfiguration: UserConfiguration{
+		UserConfigurationName: UserConfigurationName{
+			Name:                  "MyApp.Settings",
+			DistinguishedFolderId: new(DistinguishedFolderId).WithId(DistinguishedFolderId_Root),
+		},
+		Dictionary: new(UserConfigurationDictionary).Set("theme", "dark"),
+	}}
+
+	x, err := xml.MarshalIndent(req, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:CreateUserConfiguration>
+  <m:UserConfiguration>
+    <UserConfigurationName Name="MyApp.Settings">
+      <DistinguishedFolderId Id="root"></DistinguishedFolderId>
+    </UserConfigurationName>
+    <Dictionary>
+      <DictionaryEntry>
+        <DictionaryKey>
+          <Type>String</Type>
+          <Value>theme</Value>
+        </DictionaryKey>
+        <DictionaryValue>
+          <Type>String</Type>
+          <Value>dark</Value>
+        </DictionaryValue>
+      </DictionaryEntry>
+    </Dictionary>
+  </m:UserConfiguration>
+</m:CreateUserConfiguration>`, string(x))
+}
+
+func TestGetUserConfiguration_MarshalXML(t *testing.T) {
+	x, err := xml.Marshal(GetUserConfiguration{
+		UserConfigurationName: UserConfigurationName{
+			Name:     "MyApp.Settings",
+			FolderId: &FolderId{Id: "AAMkAF"},
+		},
+		UserConfigurationProperties: UserConfigurationProperties{
+			UserConfigurationProperty_Id,
+			UserConfigurationProperty_Dictionary,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<m:GetUserConfiguration><m:UserConfigurationName Name="MyApp.Settings">`+
+		`<FolderId Id="AAMkAF"></FolderId></m:UserConfigurationName>`+
+		`<m:UserConfigurationProperties>Id Dictionary</m:UserConfigurationProperties>`+
+		`</m:GetUserConfiguration>`, string(x))
+}
+
+func TestGetUserConfigurationResponseMessage(t *testing.T) {
+	const data = `<m:GetUserConfigurationResponseMessage ResponseClass="Success"
+    xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+    xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <m:ResponseCode>NoError</m:ResponseCode>
+  <m:UserConfiguration>
+    <t:UserConfigurationName Name="MyApp.Settings"><t:FolderId Id="AAMkAF"/></t:UserConfigurationName>
+    <t:ItemId Id="AAMkAD" ChangeKey="CQAAAB"/>
+    <t:Dictionary>
+      <t:DictionaryEntry>
+        <t:DictionaryKey><t:Type>String</t:Type><t:Value>theme</t:Value></t:DictionaryKey>
+        <t:DictionaryValue><t:Type>String</t:Type><t:Value>dark</t:Value></t:DictionaryValue>
+      </t:DictionaryEntry>
+      <t:DictionaryEntry>
+        <t:DictionaryKey><t:Type>String</t:Type><t:Value>empty</t:Value></t:DictionaryKey>
+      </t:DictionaryEntry>
+    </t:Dictionary>
+    <t:XmlData>PGEvPg==</t:XmlData>
+  </m:UserConfiguration>
+</m:GetUserConfigurationResponseMessage>`
+
+	var msg GetUserConfigurationResponseMessage
+	assert.NoError(t, xml.Unmarshal([]byte(data), &msg))
+
+	uc := msg.UserConfiguration
+	assert.Equal(t, "MyApp.Settings", uc.UserConfigurationName.Name)
+
+	v, ok := uc.Dictionary.Get("theme")
+	assert.True(t, ok)
+	assert.Equal(t, "dark", v)
+
+	v, ok = uc.Dictionary.Get("empty")
+	assert.True(t, ok)
+	assert.Equal(t, "", v)
+
+	_, ok = uc.Dictionary.Get("missing")
+	assert.False(t, ok)
+
+	xmlData, err := uc.XmlDataBytes()
+	assert.NoError(t, err)
+	assert.Equal(t, "<a/>", string(xmlData))
+}
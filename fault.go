@@ -5,6 +5,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
 )
 
 func NewError(resp *http.Response) error {
@@ -27,6 +30,26 @@ func (s SoapError) Error() string {
 	return s.Fault.Faultstring
 }
 
+// ResponseCode returns the ewsxml.ResponseCode from the detail of the fault.
+func (s SoapError) ResponseCode() ewsxml.ResponseCode {
+	return ewsxml.ResponseCode(s.Fault.Detail.ResponseCode)
+}
+
+// MessageText returns the description of the error from the detail of the
+// fault, or the faultstring when the detail has no description.
+func (s SoapError) MessageText() string {
+	if s.Fault.Detail.Message != "" {
+		return s.Fault.Detail.Message
+	}
+	return s.Fault.Faultstring
+}
+
+// Is indicates whether target is the ewsxml.ResponseCode of the fault.
+func (s SoapError) Is(target error) bool {
+	code, ok := target.(ewsxml.ResponseCode)
+	return ok && code == s.ResponseCode()
+}
+
 type HTTPError struct {
 	Status     string
 	StatusCode int
@@ -57,9 +80,16 @@ type detail struct {
 }
 
 type faultMessageXml struct {
-	LineNumber   string `xml:"LineNumber"`
-	LinePosition string `xml:"LinePosition"`
-	Violation    string `xml:"Violation"`
+	LineNumber   string                   `xml:"LineNumber"`
+	LinePosition string                   `xml:"LinePosition"`
+	Violation    string                   `xml:"Violation"`
+	Value        []ewsxml.MessageXmlValue `xml:"Value"`
+}
+
+// BackOff returns the duration the server asks the client to wait before
+// sending the next request, when the fault is caused by throttling.
+func (f *Fault) BackOff() (time.Duration, bool) {
+	return ewsxml.MessageXml{Value: f.Detail.MessageXml.Value}.BackOff()
 }
 
 func parseSoapFault(soapMessage string) (*Fault, error) {
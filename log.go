@@ -1,10 +1,12 @@
 package ews
 
 import (
+	"bytes"
 	"context"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"regexp"
 
 	"github.com/Abovo-Media/go-ews/ewsxml"
 )
@@ -56,7 +58,7 @@ func (l *DefaultLogger) HttpResponse(ctx context.Context, resp *http.Response) {
 	if err != nil {
 		l.log().Println("Dump error:", err)
 	} else {
-		l.log().Printf("Response:\n%s%s\n----\n", dump)
+		l.log().Printf("Response:\n%s\n----\n", dump)
 	}
 }
 
@@ -67,6 +69,58 @@ func (l *DefaultLogger) Response(ctx context.Context, resp ewsxml.ResponseMessag
 	l.log().Printf("%s: %s (%s)", resp.ResponseClass, resp.MessageText, resp.ResponseCode)
 }
 
+// Redacted replaces credentials in logged requests.
+const Redacted = "REDACTED"
+
+// redactedHeaders are the headers which contain credentials.
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization"}
+
+// wsse:Password elements of a WS-Security header
+var wssePassword = regexp.MustCompile(`(<(?:[\w-]+:)?Password\b[^>]*>)[^<]*(</(?:[\w-]+:)?Password>)`)
+
+// logRequest logs req and body with the Logger of the Client, after removing
+// any credentials from both, so logs are safe to share.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, body []byte) {
+	if _, ok := c.log.(*nopLogger); ok {
+		return
+	}
+	c.log.HttpRequest(ctx, redactRequest(req), redactBody(body))
+}
+
+// HttpErrorLogger is an optional interface a Logger can implement to be
+// notified when a request, logged with HttpRequest, fails without a response
+// being passed to HttpResponse.
+type HttpErrorLogger interface {
+	HttpError(ctx context.Context, req *http.Request, err error)
+}
+
+func (c *Client) logHttpError(ctx context.Context, req *http.Request, err error) {
+	if l, ok := c.log.(HttpErrorLogger); ok {
+		l.HttpError(ctx, req, err)
+	}
+}
+
+// redactRequest returns a shallow copy of req where the values of headers
+// which contain credentials are replaced with Redacted.
+func redactRequest(req *http.Request) *http.Request {
+	clone := req.Clone(req.Context())
+	for _, h := range redactedHeaders {
+		if clone.Header.Get(h) != "" {
+			clone.Header.Set(h, Redacted)
+		}
+	}
+	return clone
+}
+
+// redactBody replaces the contents of password elements in body with
+// Redacted.
+func redactBody(body []byte) []byte {
+	if !bytes.Contains(body, []byte("Password")) {
+		return body
+	}
+	return wssePassword.ReplaceAll(body, []byte("${1}"+Redacted+"${2}"))
+}
+
 func NopLogger() Logger { return new(nopLogger) }
 
 type nopLogger struct{}
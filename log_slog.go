@@ -0,0 +1,99 @@
+//go:build go1.21
+
+package ews
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+)
+
+// SlogLogger returns a Logger which writes structured log records to l. Http
+// requests and responses are logged with level debug, failed http requests and
+// response messages which contain an error or warning with level warn.
+func SlogLogger(l *slog.Logger) Logger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return &slogLogger{log: l}
+}
+
+type slogLogger struct {
+	log   *slog.Logger
+	start sync.Map
+}
+
+type slogAttemptKey struct {
+	requestId string
+	attempt   uint8
+}
+
+func newSlogAttemptKey(ctx context.Context) slogAttemptKey {
+	id, _ := RequestId(ctx)
+	attempt, _ := RequestAttempt(ctx)
+	return slogAttemptKey{requestId: id, attempt: attempt}
+}
+
+func requestAttrs(ctx context.Context) []slog.Attr {
+	attrs := make([]slog.Attr, 0, 2)
+	if id, ok := RequestId(ctx); ok {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	if attempt, ok := RequestAttempt(ctx); ok {
+		attrs = append(attrs, slog.Int("attempt", int(attempt)))
+	}
+	return attrs
+}
+
+func (l *slogLogger) NewClient(conf Config) {
+	l.log.Info("ews client",
+		slog.String("url", conf.Url),
+		slog.String("version", string(conf.Version)),
+	)
+}
+
+func (l *slogLogger) HttpRequest(ctx context.Context, req *http.Request, body []byte) {
+	l.start.Store(newSlogAttemptKey(ctx), time.Now())
+	l.log.LogAttrs(ctx, slog.LevelDebug, "ews http request", append(requestAttrs(ctx),
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Int("bytes", len(body)),
+	)...)
+}
+
+func (l *slogLogger) HttpResponse(ctx context.Context, resp *http.Response) {
+	attrs := append(requestAttrs(ctx),
+		slog.Int("status", resp.StatusCode),
+		slog.Int64("bytes", resp.ContentLength),
+	)
+	if start, ok := l.start.LoadAndDelete(newSlogAttemptKey(ctx)); ok {
+		attrs = append(attrs, slog.Duration("duration", time.Since(start.(time.Time))))
+	}
+	l.log.LogAttrs(ctx, slog.LevelDebug, "ews http response", attrs...)
+}
+
+func (l *slogLogger) HttpError(ctx context.Context, req *http.Request, err error) {
+	attrs := append(requestAttrs(ctx),
+		slog.String("url", req.URL.String()),
+		slog.String("error", err.Error()),
+	)
+	if start, ok := l.start.LoadAndDelete(newSlogAttemptKey(ctx)); ok {
+		attrs = append(attrs, slog.Duration("duration", time.Since(start.(time.Time))))
+	}
+	l.log.LogAttrs(ctx, slog.LevelWarn, "ews http error", attrs...)
+}
+
+func (l *slogLogger) Response(ctx context.Context, resp ewsxml.ResponseMessage) {
+	if resp.ResponseCode == ewsxml.NoError {
+		return
+	}
+	l.log.LogAttrs(ctx, slog.LevelWarn, "ews response", append(requestAttrs(ctx),
+		slog.String("class", string(resp.ResponseClass)),
+		slog.String("code", string(resp.ResponseCode)),
+		slog.String("message", resp.MessageText),
+	)...)
+}
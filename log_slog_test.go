@@ -0,0 +1,70 @@
+//go:build go1.21
+
+package ews
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlogLogger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(soapMessage))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	c, err := NewClient(srv.URL, Exchange2013, WithLogger(SlogLogger(l)), WithBasicAuth("user", "secret"), WithAllowInsecure())
+	assert.NoError(t, err)
+
+	var out []byte
+	assert.NoError(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out))
+
+	logs := buf.String()
+	assert.Contains(t, logs, `msg="ews client" url=`+srv.URL+` version=Exchange2013`)
+	assert.Contains(t, logs, `msg="ews http request" request_id=`)
+	assert.Contains(t, logs, `attempt=1 method=POST url=`+srv.URL)
+	assert.Contains(t, logs, `msg="ews http response"`)
+	assert.Contains(t, logs, `status=200`)
+	assert.Contains(t, logs, `duration=`)
+	assert.NotContains(t, logs, "secret")
+}
+
+func TestSlogLogger_HttpError(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	sl := SlogLogger(l)
+
+	c, err := NewClient("http://localhost", Exchange2013,
+		WithLogger(sl),
+		WithTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})),
+	)
+	assert.NoError(t, err)
+
+	var out []byte
+	assert.Error(t, c.Request(NewRequest(context.Background(), nil, ewsxml.GetRoomLists{}), &out))
+
+	logs := buf.String()
+	assert.Contains(t, logs, `msg="ews http error" request_id=`)
+	assert.Contains(t, logs, `error=`)
+	assert.Contains(t, logs, `duration=`)
+
+	var entries int
+	sl.(*slogLogger).start.Range(func(any, any) bool {
+		entries++
+		return true
+	})
+	assert.Equal(t, 0, entries)
+}
@@ -0,0 +1,43 @@
+package ews
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordLogger struct {
+	nopLogger
+	req  *http.Request
+	body []byte
+}
+
+func (l *recordLogger) HttpRequest(_ context.Context, req *http.Request, body []byte) {
+	l.req = req
+	l.body = body
+}
+
+func TestClient_logRequest(t *testing.T) {
+	var l recordLogger
+	c, err := NewClient("https://example.com/EWS/Exchange.asmx", Exchange2013, WithLogger(&l))
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, c.Url, nil)
+	assert.NoError(t, err)
+	req.SetBasicAuth("user", "secret")
+
+	c.logRequest(context.Background(), req, []byte(`<soap:Header><wsse:Security><wsse:UsernameToken>`+
+		`<wsse:Username>user</wsse:Username><wsse:Password Type="PasswordText">s3cr&lt;t</wsse:Password>`+
+		`</wsse:UsernameToken></wsse:Security></soap:Header>`))
+
+	assert.Equal(t, Redacted, l.req.Header.Get("Authorization"))
+	assert.Equal(t, `<soap:Header><wsse:Security><wsse:UsernameToken>`+
+		`<wsse:Username>user</wsse:Username><wsse:Password Type="PasswordText">REDACTED</wsse:Password>`+
+		`</wsse:UsernameToken></wsse:Security></soap:Header>`, string(l.body))
+
+	// original request is not modified
+	_, pass, _ := req.BasicAuth()
+	assert.Equal(t, "secret", pass)
+}
@@ -1,11 +1,17 @@
 package ews
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/base64"
+	"math"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/Abovo-Media/go-ews/ewsxml"
 	"github.com/Azure/go-ntlmssp"
+	"github.com/go-pogo/errors"
 )
 
 type Option interface {
@@ -20,14 +26,21 @@ var (
 	DefaultVersion          = Exchange2013
 	DefaultRetries    uint8 = 3
 	DefaultRetrySleep       = time.Second
+	DefaultTimeout          = time.Second * 10
+	// MaxRetrySleep limits the exponentially growing delay between retries.
+	MaxRetrySleep = time.Minute * 5
 )
 
 type Config struct {
-	Version    Version
-	Url        string
-	Username   string
-	Password   string
-	Retries    uint8
+	Version  Version
+	Url      string
+	Domain   string
+	Username string
+	Password string
+	// Retries is the maximum number of times a request is retried after a
+	// transient failure.
+	Retries uint8
+	// RetrySleep is the initial delay between retries.
 	RetrySleep time.Duration
 }
 
@@ -42,6 +55,9 @@ func (conf *Config) apply(client *Client) error {
 	if conf.Url != "" {
 		client.Url = conf.Url
 	}
+	if conf.Domain != "" {
+		client.Domain = conf.Domain
+	}
 	if (conf.Username == "" && conf.Password == "") || (conf.Username != "" && conf.Password != "") {
 		client.Username = conf.Username
 		client.Password = conf.Password
@@ -72,6 +88,11 @@ func WithOptions(opts ...Option) Option {
 	})
 }
 
+// WithTimeout sets the time limit of a single http request, including
+// reading the response body. A zero value means no timeout. The timeout
+// applies to each attempt of a retried request, in addition to any deadline
+// of the request's context, where the one which expires first wins. It does
+// not apply to requests executed with Client.Stream.
 func WithTimeout(t time.Duration) Option {
 	return optionFunc(func(c *Client) error {
 		c.http.Timeout = t
@@ -79,6 +100,23 @@ func WithTimeout(t time.Duration) Option {
 	})
 }
 
+// WithRetry retries requests which fail because of a transient server error,
+// like ErrorServerBusy or a 429 or 503 status, at most maxRetries times. The
+// delay between attempts is base, doubled after each attempt up to
+// MaxRetrySleep, unless the server indicates how long to back off. Retries
+// stop when the context of the request is done or its deadline would expire
+// while waiting. Transport errors are not retried.
+func WithRetry(maxRetries int, base time.Duration) Option {
+	return optionFunc(func(c *Client) error {
+		if maxRetries < 0 || maxRetries > math.MaxUint8 {
+			return errors.New(ErrInvalidRetries)
+		}
+		c.Retries = uint8(maxRetries)
+		c.RetrySleep = base
+		return nil
+	})
+}
+
 func WithRetries(n uint8) Option {
 	return optionFunc(func(c *Client) error {
 		c.Retries = n
@@ -94,6 +132,59 @@ func WithRetriesAndSleep(retries uint8, sleep time.Duration) Option {
 	})
 }
 
+// WithGzip compresses the body of requests using gzip and asks the server to
+// compress its responses as well. Compressed responses are decompressed
+// transparently, while plain responses from servers which do not support
+// compression are read as is.
+func WithGzip() Option {
+	return optionFunc(func(c *Client) error {
+		c.gzip = true
+		return nil
+	})
+}
+
+// WithImpersonation impersonates the user identified by sid in all requests,
+// unless the ewsxml.Header of a request already contains an
+// ExchangeImpersonation.
+func WithImpersonation(sid ewsxml.ConnectingSID) Option {
+	return optionFunc(func(c *Client) error {
+		c.impersonate = &sid
+		return nil
+	})
+}
+
+// WithTimeZone adds a TimeZoneContext header with time zone id to all
+// requests, unless the ewsxml.Header of a request already contains a
+// TimeZoneContext. Exchange then interprets and returns times, like the Start
+// and End of a CalendarItem, in this time zone instead of the server's default
+// time zone.
+func WithTimeZone(id ewsxml.TimeZoneId) Option {
+	return optionFunc(func(c *Client) error {
+		c.timeZone = id
+		return nil
+	})
+}
+
+// WithMailboxCulture adds a MailboxCulture header with culture, like "en-US",
+// to all requests, unless the ewsxml.Header of a request already contains a
+// MailboxCulture.
+func WithMailboxCulture(culture ewsxml.MailboxCulture) Option {
+	return optionFunc(func(c *Client) error {
+		c.culture = culture
+		return nil
+	})
+}
+
+// WithAllowInsecure allows sending credentials, like basic auth, NTLM,
+// WS-Security or OAuth2 tokens, to an EWS url with the plain http scheme.
+// NewClient rejects this by default. It should only be used with test servers.
+func WithAllowInsecure() Option {
+	return optionFunc(func(c *Client) error {
+		c.insecure = true
+		return nil
+	})
+}
+
 func WithBasicAuth(user, pass string) Option {
 	return optionFunc(func(c *Client) error {
 		c.Username = user
@@ -102,21 +193,163 @@ func WithBasicAuth(user, pass string) Option {
 	})
 }
 
+// TokenSource provides OAuth2 access tokens. It is queried for each request so
+// implementations are free to return a rotated or refreshed token.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// TokenSourceFunc is a TokenSource in the form of a func.
+type TokenSourceFunc func() (string, error)
+
+func (fn TokenSourceFunc) Token() (string, error) { return fn() }
+
+type staticToken string
+
+func (t staticToken) Token() (string, error) { return string(t), nil }
+
+// WithUsernameToken authenticates all requests using a WS-Security header with
+// a UsernameToken containing user and pass, unless the ewsxml.Header of a
+// request already contains a Security header. It can be combined with http
+// authentication when the server requires both.
+func WithUsernameToken(user, pass string) Option {
+	return optionFunc(func(c *Client) error {
+		c.wsse = &ewsxml.UsernameToken{
+			Username: user,
+			Password: ewsxml.UsernameTokenPassword{Type: ewsxml.PasswordTextType, Value: pass},
+		}
+		return nil
+	})
+}
+
+// CredentialsProvider provides the value of the Authorization header of
+// requests. It is queried for each request, so implementations are free to
+// return refreshed credentials, for example a new OAuth2 access token which is
+// obtained using a client credentials flow.
+type CredentialsProvider interface {
+	AuthHeader(ctx context.Context) (string, error)
+}
+
+// CredentialsProviderFunc is a CredentialsProvider in the form of a func.
+type CredentialsProviderFunc func(ctx context.Context) (string, error)
+
+func (fn CredentialsProviderFunc) AuthHeader(ctx context.Context) (string, error) {
+	return fn(ctx)
+}
+
+// BasicCredentials returns a CredentialsProvider which authenticates requests
+// using basic auth.
+func BasicCredentials(user, pass string) CredentialsProvider {
+	header := "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+pass))
+	return CredentialsProviderFunc(func(context.Context) (string, error) {
+		return header, nil
+	})
+}
+
+// BearerCredentials returns a CredentialsProvider which authenticates
+// requests using the OAuth2 bearer tokens from ts.
+func BearerCredentials(ts TokenSource) CredentialsProvider {
+	return CredentialsProviderFunc(func(context.Context) (string, error) {
+		token, err := ts.Token()
+		if err != nil {
+			return "", err
+		}
+		return "Bearer " + token, nil
+	})
+}
+
+// WithCredentials sets a CredentialsProvider which is queried for the value
+// of the Authorization header on each request. It cannot be combined with
+// basic authentication or a TokenSource.
+func WithCredentials(cp CredentialsProvider) Option {
+	return optionFunc(func(c *Client) error {
+		c.creds = cp
+		return nil
+	})
+}
+
+// WithOAuth2 sets a static OAuth2 bearer token which is sent with each request.
+// It cannot be combined with basic authentication.
+func WithOAuth2(token string) Option {
+	return WithTokenSource(staticToken(token))
+}
+
+// WithTokenSource sets a TokenSource which is queried for an OAuth2 bearer
+// token on each request. It cannot be combined with basic authentication.
+func WithTokenSource(ts TokenSource) Option {
+	return optionFunc(func(c *Client) error {
+		c.tokens = ts
+		return nil
+	})
+}
+
+// WithHTTPClient sets the http.Client which is used to send requests. A copy
+// of hc is used, so options which are applied after WithHTTPClient, like
+// WithNTLM and WithTimeout, do not change hc itself. Its Transport is shared
+// with the copy though, so options which modify the transport, like
+// WithSkipTLS, also affect other users of it.
+// The CheckRedirect func of hc is kept as is. Redirects of EWS requests
+// should not be followed, which is the default behavior of clients created by
+// NewClient. Use WithoutRedirects to apply this behavior to the copy of hc.
+func WithHTTPClient(hc *http.Client) Option {
+	return optionFunc(func(c *Client) error {
+		if hc != nil {
+			cp := *hc
+			c.http = &cp
+		}
+		return nil
+	})
+}
+
+// WithoutRedirects prevents the http.Client from following redirects, so the
+// redirect response is returned instead.
+func WithoutRedirects() Option {
+	return optionFunc(func(c *Client) error {
+		c.http.CheckRedirect = noRedirect
+		return nil
+	})
+}
+
+func noRedirect(*http.Request, []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
 func WithTransport(t http.RoundTripper) Option {
 	return withTransport(t, false)
 }
 
+// WithDefaultTransport uses a clone of http.DefaultTransport, so options like
+// WithSkipTLS and WithProxy do not modify the transport which is shared with
+// all other users of http.DefaultTransport.
 func WithDefaultTransport(skipTls bool) Option {
-	return withTransport(http.DefaultTransport, skipTls)
+	var t http.RoundTripper = http.DefaultTransport
+	if dt, ok := t.(*http.Transport); ok {
+		t = dt.Clone()
+	}
+	return withTransport(t, skipTls)
 }
 
-func WithNTLM(skipTls bool) Option {
-	return withTransport(new(ntlmssp.Negotiator), skipTls)
+// WithNTLM authenticates requests using NTLM. The current transport is wrapped
+// in a round tripper which handles the NTLM handshake. This transport,
+// http.DefaultTransport when none is set, should keep connections alive
+// because NTLM authenticates the connection rather than the request.
+// Config.Username remains the plain username without domain.
+func WithNTLM(domain, user, pass string) Option {
+	return optionFunc(func(c *Client) error {
+		c.Domain = domain
+		c.Username = user
+		c.Password = pass
+
+		if _, ok := c.http.Transport.(ntlmssp.Negotiator); !ok {
+			c.http.Transport = ntlmssp.Negotiator{RoundTripper: c.http.Transport}
+		}
+		return nil
+	})
 }
 
 func WithSkipTLS() Option {
 	return optionFunc(func(c *Client) error {
-		if t, ok := c.http.Transport.(*http.Transport); ok {
+		if t, ok := c.httpTransport(); ok {
 			if t.TLSClientConfig == nil {
 				t.TLSClientConfig = new(tls.Config)
 			}
@@ -126,6 +359,69 @@ func WithSkipTLS() Option {
 	})
 }
 
+// WithProxy sends all requests through the proxy at proxyUrl. Both http(s)
+// and socks5 proxies are supported. Credentials for the proxy itself can be
+// provided as userinfo of proxyUrl, which are sent using basic auth. NTLM
+// authentication, see WithNTLM, is performed with the EWS server through the
+// proxy and requires the proxy to keep the connection to the server alive.
+// The proxy can only be set on an *http.Transport, which is the default
+// transport, and not on a custom http.RoundTripper.
+func WithProxy(proxyUrl string) Option {
+	return optionFunc(func(c *Client) error {
+		u, err := url.Parse(proxyUrl)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return setProxy(c, http.ProxyURL(u))
+	})
+}
+
+// WithEnvironmentProxy sends requests through the proxy which is configured
+// using the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables. See
+// WithProxy for more details.
+func WithEnvironmentProxy() Option {
+	return optionFunc(func(c *Client) error {
+		return setProxy(c, http.ProxyFromEnvironment)
+	})
+}
+
+func setProxy(c *Client, proxy func(*http.Request) (*url.URL, error)) error {
+	t, ok := c.httpTransport()
+	if !ok {
+		return errors.New(ErrProxyTransport)
+	}
+	t.Proxy = proxy
+	return nil
+}
+
+// httpTransport returns the *http.Transport of the http.Client, which may be
+// wrapped by an ntlmssp.Negotiator. When no transport is set, the client gets
+// a clone of http.DefaultTransport so it can be modified safely.
+func (c *Client) httpTransport() (*http.Transport, bool) {
+	t := c.http.Transport
+	n, isNtlm := t.(ntlmssp.Negotiator)
+	if isNtlm {
+		t = n.RoundTripper
+	}
+	if t == nil {
+		dt, ok := http.DefaultTransport.(*http.Transport)
+		if !ok {
+			return nil, false
+		}
+
+		t = dt.Clone()
+		if isNtlm {
+			n.RoundTripper = t
+			c.http.Transport = n
+		} else {
+			c.http.Transport = t
+		}
+	}
+
+	ht, ok := t.(*http.Transport)
+	return ht, ok
+}
+
 func withTransport(t http.RoundTripper, skipTls bool) Option {
 	return optionFunc(func(c *Client) error {
 		c.http.Transport = t
@@ -17,9 +17,10 @@ import (
 const panicNilBody = "ews.NewRequest: body must be a non-nil value"
 
 type Request struct {
-	ctx  context.Context
-	head *ewsxml.Header
-	body interface{}
+	ctx   context.Context
+	head  *ewsxml.Header
+	body  interface{}
+	creds CredentialsProvider
 }
 
 func NewRequest(ctx context.Context, head *ewsxml.Header, body interface{}) *Request {
@@ -43,6 +44,26 @@ func NewRequest(ctx context.Context, head *ewsxml.Header, body interface{}) *Req
 func (r *Request) Header() *ewsxml.Header { return r.head }
 func (r *Request) Body() interface{}      { return r.body }
 
+// WithCredentials authenticates the Request using cp instead of the
+// credentials of the Client.
+func (r *Request) WithCredentials(cp CredentialsProvider) *Request {
+	r.creds = cp
+	return r
+}
+
+// WithOAuth2 authenticates the Request using the static OAuth2 bearer token
+// instead of the credentials of the Client.
+func (r *Request) WithOAuth2(token string) *Request {
+	return r.WithCredentials(BearerCredentials(staticToken(token)))
+}
+
+// WithImpersonation impersonates the user identified by sid, instead of the
+// user which is impersonated by the Client, if any.
+func (r *Request) WithImpersonation(sid ewsxml.ConnectingSID) *Request {
+	r.head.WithImpersonation(sid)
+	return r
+}
+
 //goland:noinspection HttpUrlsUsage
 var (
 	soapStart = []byte(xml.Header + `<soap:Envelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
@@ -4,7 +4,7 @@ import (
 	"net/http"
 )
 
-func newTestClient(ver Version, t *testTransport) (Client, error) {
+func newTestClient(ver Version, t *testTransport) (*Client, error) {
 	return NewClient("test.client", ver, WithTransport(t))
 }
 
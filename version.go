@@ -0,0 +1,121 @@
+package ews
+
+import (
+	"context"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+	"github.com/go-pogo/errors"
+)
+
+const ErrNoServerVersion errors.Msg = "response does not contain server version info"
+
+type Version = ewsxml.Version
+
+//goland:noinspection GoUnusedConst,GoSnakeCaseUsage
+const (
+	Exchange2007     Version = "Exchange2007"
+	Exchange2007_SP1 Version = "Exchange2007_SP1"
+	Exchange2010     Version = "Exchange2010"
+	Exchange2010_SP1 Version = "Exchange2010_SP1"
+	Exchange2010_SP2 Version = "Exchange2010_SP2"
+	Exchange2013     Version = "Exchange2013"
+	Exchange2013_SP1 Version = "Exchange2013_SP1"
+	Exchange2015     Version = "Exchange2015"
+	Exchange2016     Version = "Exchange2016"
+)
+
+// versions contains all known Versions, from oldest to newest.
+var versions = []Version{
+	Exchange2007,
+	Exchange2007_SP1,
+	Exchange2010,
+	Exchange2010_SP1,
+	Exchange2010_SP2,
+	Exchange2013,
+	Exchange2013_SP1,
+	Exchange2015,
+	Exchange2016,
+}
+
+func versionIndex(v Version) int {
+	for i, x := range versions {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// KnownVersion indicates if v is one of the known Versions.
+func KnownVersion(v Version) bool { return versionIndex(v) >= 0 }
+
+// CompareVersions returns -1 when a is older than b, 1 when a is newer than b
+// and 0 when both are equal. Unknown versions are considered older than any
+// known version.
+func CompareVersions(a, b Version) int {
+	ai, bi := versionIndex(a), versionIndex(b)
+	switch {
+	case ai < bi:
+		return -1
+	case ai > bi:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// VersionAtLeast indicates if v is the same as or newer than min. Use it to
+// check if a server supports an operation which requires a minimum version.
+func VersionAtLeast(v, min Version) bool { return CompareVersions(v, min) >= 0 }
+
+// VersionFromServerInfo returns the newest Version which is supported by the
+// server described by info. It returns an empty Version when the server is
+// older than Exchange2007.
+func VersionFromServerInfo(info ewsxml.ServerVersionInfo) Version {
+	switch {
+	case info.MajorVersion < 8:
+		return ""
+	case info.MajorVersion == 8 && info.MinorVersion == 0:
+		return Exchange2007
+	case info.MajorVersion < 14:
+		return Exchange2007_SP1
+	case info.MajorVersion == 14 && info.MinorVersion == 0:
+		return Exchange2010
+	case info.MajorVersion == 14 && info.MinorVersion == 1:
+		return Exchange2010_SP1
+	case info.MajorVersion == 14:
+		return Exchange2010_SP2
+	case info.MajorVersion == 15 && info.MinorVersion == 0 && info.MajorBuildNumber < 847:
+		// Exchange 2013 SP1 has build number 15.0.847
+		return Exchange2013
+	case info.MajorVersion == 15 && info.MinorVersion == 0:
+		return Exchange2013_SP1
+	default:
+		// Exchange 2016 and newer, including Exchange Online
+		return Exchange2016
+	}
+}
+
+// DetectVersion sends a lightweight ResolveNames request and returns the
+// newest Version which is supported by the server, based on the
+// ServerVersionInfo of the response. The result of the name resolution itself
+// is ignored. The detected Version can be used with NewClient or assigned to
+// Config.Version.
+func (c *Client) DetectVersion(ctx context.Context) (Version, error) {
+	entry := c.Username
+	if entry == "" {
+		entry = "a"
+	}
+
+	head := new(ewsxml.Header).WithServerVersion(Exchange2010)
+	var out []byte
+	if err := c.Request(NewRequest(ctx, head, ewsxml.ResolveNames{UnresolvedEntry: entry}), &out); err != nil {
+		return "", err
+	}
+
+	info, ok := c.ServerVersionInfo()
+	if !ok {
+		return "", errors.New(ErrNoServerVersion)
+	}
+	return VersionFromServerInfo(info), nil
+}
@@ -0,0 +1,70 @@
+package ews
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Abovo-Media/go-ews/ewsxml"
+
+	"github.com/go-pogo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, CompareVersions(Exchange2013, Exchange2013))
+	assert.Equal(t, -1, CompareVersions(Exchange2010_SP2, Exchange2013))
+	assert.Equal(t, 1, CompareVersions(Exchange2016, Exchange2013_SP1))
+	assert.Equal(t, -1, CompareVersions("Exchange2000", Exchange2007))
+
+	assert.True(t, VersionAtLeast(Exchange2013_SP1, Exchange2010))
+	assert.True(t, VersionAtLeast(Exchange2013, Exchange2013))
+	assert.False(t, VersionAtLeast(Exchange2007_SP1, Exchange2010))
+}
+
+func TestNewClient_Version(t *testing.T) {
+	c, err := NewClient("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultVersion, c.Version)
+
+	c, err = NewClient("", Exchange2010_SP1)
+	assert.NoError(t, err)
+	assert.Equal(t, Exchange2010_SP1, c.Version)
+
+	_, err = NewClient("", "Exchange2099")
+	assert.True(t, errors.Is(err, ErrUnknownVersion))
+}
+
+func TestVersionFromServerInfo(t *testing.T) {
+	tests := map[Version]ewsxml.ServerVersionInfo{
+		"":               {MajorVersion: 6, MinorVersion: 5},
+		Exchange2007_SP1: {MajorVersion: 8, MinorVersion: 3},
+		Exchange2010:     {MajorVersion: 14, MinorVersion: 0},
+		Exchange2010_SP2: {MajorVersion: 14, MinorVersion: 3},
+		Exchange2013:     {MajorVersion: 15, MinorVersion: 0, MajorBuildNumber: 516},
+		Exchange2013_SP1: {MajorVersion: 15, MinorVersion: 0, MajorBuildNumber: 847},
+		Exchange2016:     {MajorVersion: 15, MinorVersion: 20, MajorBuildNumber: 2495},
+	}
+	for want, info := range tests {
+		assert.Equal(t, want, VersionFromServerInfo(info))
+	}
+}
+
+func TestClient_DetectVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(data), `<RequestServerVersion Version="Exchange2010">`)
+		assert.Contains(t, string(data), `<m:UnresolvedEntry>user</m:UnresolvedEntry>`)
+		_, _ = w.Write([]byte(soapMessage))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL, Exchange2013, WithBasicAuth("user", "pass"), WithAllowInsecure())
+	assert.NoError(t, err)
+
+	ver, err := c.DetectVersion(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Exchange2016, ver)
+}